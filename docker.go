@@ -816,6 +816,29 @@ func (n *DockerNetwork) Remove(ctx context.Context) error {
 	return n.provider.client.NetworkRemove(ctx, n.ID)
 }
 
+// Connect attaches a running container to the network, setting the given aliases
+// on the network endpoint. It can be used together with Disconnect to simulate
+// network partitions between containers.
+func (n *DockerNetwork) Connect(ctx context.Context, c Container, aliases ...string) error {
+	if n.provider == nil {
+		return fmt.Errorf("network %s is not managed by a Docker provider", n.Name)
+	}
+
+	return n.provider.client.NetworkConnect(ctx, n.ID, c.GetContainerID(), &network.EndpointSettings{
+		Aliases: aliases,
+	})
+}
+
+// Disconnect detaches a running container from the network, so that it's no longer
+// reachable by the rest of the containers attached to it.
+func (n *DockerNetwork) Disconnect(ctx context.Context, c Container) error {
+	if n.provider == nil {
+		return fmt.Errorf("network %s is not managed by a Docker provider", n.Name)
+	}
+
+	return n.provider.client.NetworkDisconnect(ctx, n.ID, c.GetContainerID(), true)
+}
+
 // DockerProvider implements the ContainerProvider interface
 type DockerProvider struct {
 	*DockerProviderOptions
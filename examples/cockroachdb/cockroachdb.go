@@ -1,46 +0,0 @@
-package cockroachdb
-
-import (
-	"context"
-	"fmt"
-
-	_ "github.com/jackc/pgx/v4/stdlib"
-
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/wait"
-)
-
-type cockroachDBContainer struct {
-	testcontainers.Container
-	URI string
-}
-
-func startContainer(ctx context.Context) (*cockroachDBContainer, error) {
-	req := testcontainers.ContainerRequest{
-		Image:        "cockroachdb/cockroach:latest-v21.1",
-		ExposedPorts: []string{"26257/tcp", "8080/tcp"},
-		WaitingFor:   wait.ForHTTP("/health").WithPort("8080"),
-		Cmd:          []string{"start-single-node", "--insecure"},
-	}
-	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          true,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	mappedPort, err := container.MappedPort(ctx, "26257")
-	if err != nil {
-		return nil, err
-	}
-
-	hostIP, err := container.Host(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	uri := fmt.Sprintf("postgres://root@%s:%s", hostIP, mappedPort.Port())
-
-	return &cockroachDBContainer{Container: container, URI: uri}, nil
-}
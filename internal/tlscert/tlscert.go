@@ -0,0 +1,94 @@
+package tlscert
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+// CA is a self-signed certificate authority, generated on the fly to sign the
+// certificates used by the modules to enable TLS. It's valid for a day.
+type CA struct {
+	// Cert is the PEM encoded certificate of the CA, to be trusted by the clients.
+	Cert []byte
+
+	template *x509.Certificate
+	key      *rsa.PrivateKey
+	serial   int64
+}
+
+// NewCA creates a self-signed CA with the given common name.
+func NewCA(commonName string) (*CA, error) {
+	notBefore := time.Now().Add(-time.Hour)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate CA key: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"Testcontainers"}, CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+
+	return &CA{
+		Cert:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		template: template,
+		key:      key,
+		serial:   1,
+	}, nil
+}
+
+// Sign creates a new key and a certificate signed by the CA, with the given common name and
+// extended key usages, and valid for the given hosts, which can be DNS names or IP addresses.
+// Both the certificate and the key are returned PEM encoded.
+func (ca *CA) Sign(commonName string, extKeyUsage []x509.ExtKeyUsage, hosts ...string) ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{Organization: []string{"Testcontainers"}, CommonName: commonName},
+		NotBefore:    ca.template.NotBefore,
+		NotAfter:     ca.template.NotAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  extKeyUsage,
+	}
+
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.template, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return cert, keyPEM, nil
+}
@@ -0,0 +1,62 @@
+package tlscert
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	ca, err := NewCA("Testcontainers CA")
+	require.NoError(t, err)
+
+	cert, key, err := ca.Sign("server", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, "localhost", "127.0.0.1", "::1")
+	require.NoError(t, err)
+
+	// the certificate and the key must be a valid pair
+	_, err = tls.X509KeyPair(cert, key)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(cert)
+	require.NotNil(t, block)
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	assert.Equal(t, "server", parsed.Subject.CommonName)
+	assert.Equal(t, []string{"localhost"}, parsed.DNSNames)
+	assert.Len(t, parsed.IPAddresses, 2)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(ca.Cert))
+
+	for _, host := range []string{"localhost", "127.0.0.1", "::1"} {
+		_, err = parsed.Verify(x509.VerifyOptions{DNSName: host, Roots: roots})
+		require.NoError(t, err, host)
+	}
+
+	_, err = parsed.Verify(x509.VerifyOptions{DNSName: "example.com", Roots: roots})
+	require.Error(t, err)
+}
+
+func TestSign_uniqueSerialNumbers(t *testing.T) {
+	ca, err := NewCA("Testcontainers CA")
+	require.NoError(t, err)
+
+	serials := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		cert, _, err := ca.Sign("client", []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+		require.NoError(t, err)
+
+		block, _ := pem.Decode(cert)
+		parsed, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		serials[parsed.SerialNumber.String()] = true
+	}
+
+	assert.Len(t, serials, 3)
+}
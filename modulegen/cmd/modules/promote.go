@@ -0,0 +1,23 @@
+package modules
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/testcontainers/testcontainers-go/modulegen/internal"
+)
+
+var PromoteCmd = &cobra.Command{
+	Use:   "promote",
+	Short: "Promote an Example to a Module",
+	Long:  "Move an existing Example to the Modules, exporting its container type and entrypoint, and updating the docs and the project files",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return internal.Promote(tcModuleVar)
+	},
+}
+
+func init() {
+	PromoteCmd.Flags().StringVarP(&tcModuleVar.Name, nameFlag, "n", "", "Name of the example to be promoted. Only alphabetical characters are allowed.")
+	PromoteCmd.Flags().StringVarP(&tcModuleVar.NameTitle, titleFlag, "t", "", "(Optional) Title of the module name, used to override the name in the case of mixed casing (Mongodb -> MongoDB). Use camel-case when needed. Only alphabetical characters are allowed.")
+
+	_ = PromoteCmd.MarkFlagRequired(nameFlag)
+}
@@ -0,0 +1,16 @@
+package modules
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/testcontainers/testcontainers-go/modulegen/internal"
+)
+
+var RefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Refresh the existing Examples and Modules",
+	Long:  "Refresh the Makefile, the Go version of the go.mod file and the project files of the existing Examples and Modules, without modifying their code",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return internal.Refresh()
+	},
+}
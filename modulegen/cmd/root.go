@@ -14,4 +14,6 @@ var NewRootCmd = &cobra.Command{
 
 func init() {
 	NewRootCmd.AddCommand(modules.NewCmd)
+	NewRootCmd.AddCommand(modules.PromoteCmd)
+	NewRootCmd.AddCommand(modules.RefreshCmd)
 }
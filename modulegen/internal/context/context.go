@@ -35,6 +35,10 @@ func (ctx Context) getModulesByBaseDir(baseDir string) ([]string, error) {
 
 	allFiles, err := os.ReadDir(dir)
 	if err != nil {
+		// a project without examples or modules has no directory for them
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
 		return nil, err
 	}
 
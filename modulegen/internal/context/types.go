@@ -34,14 +34,39 @@ func (m *TestcontainersModule) ContainerName() string {
 	return name + "Container"
 }
 
-// Entrypoint returns the name of the entrypoint function, which is the lower-cased title of the example
-// If the example is a module, the entrypoint will be "RunContainer"
+// Entrypoint returns the name of the entrypoint function, which receives the Docker image to use
+// If the example is a module, the entrypoint will be "Run", otherwise "run"
 func (m *TestcontainersModule) Entrypoint() string {
 	if m.IsModule {
-		return "RunContainer"
+		return "Run"
 	}
 
-	return "runContainer"
+	return "run"
+}
+
+// PromotedIdentifiers returns the identifiers of the example to be renamed when it's promoted to the given module,
+// which must be exported: the container type and the entrypoint, including the legacy ones of the examples
+func (m *TestcontainersModule) PromotedIdentifiers(module TestcontainersModule) map[string]string {
+	return map[string]string{
+		m.ContainerName(): module.ContainerName(),
+		m.Entrypoint():    module.Entrypoint(),
+		"runContainer":    "RunContainer",
+		"startContainer":  "StartContainer",
+	}
+}
+
+// PromoteText renames the identifiers of the example mentioned in the text, e.g. docs or comments, when it's promoted
+// to the given module. The entrypoints are only renamed when they are called, as they could be regular words.
+func (m *TestcontainersModule) PromoteText(text string, module TestcontainersModule) string {
+	for oldName, newName := range m.PromotedIdentifiers(module) {
+		if oldName == m.ContainerName() {
+			text = regexp.MustCompile(`\b`+oldName+`\b`).ReplaceAllString(text, newName)
+		} else {
+			text = regexp.MustCompile(`\b`+oldName+`\(`).ReplaceAllString(text, newName+"(")
+		}
+	}
+
+	return text
 }
 
 func (m *TestcontainersModule) Lower() string {
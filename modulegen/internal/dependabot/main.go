@@ -48,3 +48,19 @@ func CopyConfig(configFile string, tmpFile string) error {
 	}
 	return writeConfig(tmpFile, config)
 }
+
+// PromoteModule replaces the update of the example with the one of the module promoted from it
+func (g Generator) PromoteModule(ctx context.Context, example context.TestcontainersModule, module context.TestcontainersModule) error {
+	configFile := ctx.DependabotConfigFile()
+
+	config, err := readConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	packageEcosystem := "gomod"
+	config.removeUpdate(newUpdate("/"+example.ParentDir()+"/"+example.Lower(), packageEcosystem))
+	config.addUpdate(newUpdate("/"+module.ParentDir()+"/"+module.Lower(), packageEcosystem))
+
+	return writeConfig(configFile, config)
+}
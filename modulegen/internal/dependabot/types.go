@@ -50,3 +50,13 @@ func (c *Config) addUpdate(newUpdate Update) {
 		sort.Sort(c.Updates)
 	}
 }
+
+func (c *Config) removeUpdate(oldUpdate Update) {
+	updates := Updates{}
+	for _, update := range c.Updates {
+		if update.Directory != oldUpdate.Directory || update.PackageEcosystem != oldUpdate.PackageEcosystem {
+			updates = append(updates, update)
+		}
+	}
+	c.Updates = updates
+}
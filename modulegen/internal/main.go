@@ -8,6 +8,7 @@ import (
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/dependabot"
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/make"
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/mkdocs"
+	"github.com/testcontainers/testcontainers-go/modulegen/internal/modfile"
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/module"
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/sonar"
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/tools"
@@ -54,6 +55,70 @@ func Generate(moduleVar context.TestcontainersModuleVar, isModule bool) error {
 	return nil
 }
 
+// Refresh re-applies the generated files to all the existing modules and examples, without touching their code
+func Refresh() error {
+	ctx, err := context.GetRootContext()
+	if err != nil {
+		return fmt.Errorf(">> could not get the root dir: %w", err)
+	}
+
+	err = RefreshFiles(ctx)
+	if err != nil {
+		return fmt.Errorf(">> error refreshing the modules: %w", err)
+	}
+
+	fmt.Println("Please check the modified files with 'git diff', where the Makefile, the Go version and the project files were refreshed.")
+	fmt.Println("🙏 Commit the modified files and submit a pull request to include them into the project.")
+	fmt.Println("Thanks!")
+	return nil
+}
+
+// Promote converts an existing example into a module, exporting its container type and entrypoint
+func Promote(moduleVar context.TestcontainersModuleVar) error {
+	ctx, err := context.GetRootContext()
+	if err != nil {
+		return fmt.Errorf(">> could not get the root dir: %w", err)
+	}
+
+	example := context.TestcontainersModule{
+		IsModule: false,
+		Name:     moduleVar.Name,
+	}
+	tcModule := context.TestcontainersModule{
+		IsModule:  true,
+		Name:      moduleVar.Name,
+		TitleName: moduleVar.NameTitle,
+	}
+	if tcModule.TitleName == "" {
+		tcModule.TitleName = tcModule.Title()
+	}
+
+	err = PromoteFiles(ctx, example, tcModule)
+	if err != nil {
+		return fmt.Errorf(">> error promoting the example: %w", err)
+	}
+
+	cmdDir := filepath.Join(ctx.RootDir, tcModule.ParentDir(), tcModule.Lower())
+	lintCmds := []func(string) error{
+		tools.GoModTidy,
+		tools.GoVet,
+		tools.MakeLint,
+	}
+
+	for _, lintCmd := range lintCmds {
+		err = lintCmd(cmdDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Please go to", cmdDir, "directory to check the results, where 'go mod tidy', 'go vet' and 'make lint' were executed.")
+	fmt.Println("Remember to review the public API of the module, and to document it in the docs/modules directory.")
+	fmt.Println("🙏 Commit the modified files and submit a pull request to include them into the project.")
+	fmt.Println("Thanks!")
+	return nil
+}
+
 type ProjectGenerator interface {
 	Generate(context.Context) error
 }
@@ -80,6 +145,84 @@ func GenerateFiles(ctx context.Context, tcModule context.TestcontainersModule) e
 		}
 	}
 
+	return generateProjectFiles(ctx)
+}
+
+// RefreshFiles regenerates the Makefile and raises the Go version of the go.mod file of each existing module
+// and example, adding the missing Dependabot updates and regenerating the project files afterwards.
+func RefreshFiles(ctx context.Context) error {
+	modules, err := ctx.GetModules()
+	if err != nil {
+		return err
+	}
+	examples, err := ctx.GetExamples()
+	if err != nil {
+		return err
+	}
+
+	var tcModules []context.TestcontainersModule
+	for _, name := range modules {
+		tcModules = append(tcModules, context.TestcontainersModule{Name: name, IsModule: true})
+	}
+	for _, name := range examples {
+		tcModules = append(tcModules, context.TestcontainersModule{Name: name, IsModule: false})
+	}
+
+	for _, tcModule := range tcModules {
+		moduleDir := filepath.Join(ctx.RootDir, tcModule.ParentDir(), tcModule.Lower())
+
+		err := make.GenerateMakefile(ctx, tcModule)
+		if err != nil {
+			return err
+		}
+
+		err = modfile.UpdateGoVersion(moduleDir, ctx.GoModFile())
+		if err != nil {
+			return err
+		}
+
+		err = dependabot.Generator{}.AddModule(ctx, tcModule)
+		if err != nil {
+			return err
+		}
+	}
+
+	return generateProjectFiles(ctx)
+}
+
+type FilePromoter interface {
+	PromoteModule(context.Context, context.TestcontainersModule, context.TestcontainersModule) error
+}
+
+// PromoteFiles moves the example to the modules, including its docs, and replaces the entries of the example
+// with the ones of the module in the project files
+func PromoteFiles(ctx context.Context, example context.TestcontainersModule, tcModule context.TestcontainersModule) error {
+	if err := tcModule.Validate(); err != nil {
+		return err
+	}
+
+	filePromoters := []FilePromoter{
+		module.Generator{},     // moves the example and exports its identifiers
+		mkdocs.Generator{},     // moves the docs and updates the nav in mkdocs
+		dependabot.Generator{}, // update modules in dependabot
+	}
+
+	for _, promoter := range filePromoters {
+		err := promoter.PromoteModule(ctx, example, tcModule)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := make.GenerateMakefile(ctx, tcModule)
+	if err != nil {
+		return err
+	}
+
+	return generateProjectFiles(ctx)
+}
+
+func generateProjectFiles(ctx context.Context) error {
 	// they are based on the content of the modules in the project workspace,
 	// not in the new module to be added, that's why they happen after the actual
 	// module generation
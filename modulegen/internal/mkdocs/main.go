@@ -1,7 +1,9 @@
 package mkdocs
 
 import (
+	"os"
 	"path/filepath"
+	"regexp"
 	"text/template"
 
 	"github.com/testcontainers/testcontainers-go/modulegen/internal/context"
@@ -37,6 +39,45 @@ func (g Generator) AddModule(ctx context.Context, tcModule context.Testcontainer
 	return writeConfig(configFile, config)
 }
 
+// PromoteModule moves the docs of the example to the modules, updating the paths and the identifiers
+// referenced by the docs, and replacing the nav item of the example with the one of the module
+func (g Generator) PromoteModule(ctx context.Context, example context.TestcontainersModule, module context.TestcontainersModule) error {
+	exampleMdFile := filepath.Join(ctx.DocsDir(), example.ParentDir(), example.Lower()+".md")
+	moduleMdFile := filepath.Join(ctx.DocsDir(), module.ParentDir(), module.Lower()+".md")
+
+	content, err := os.ReadFile(exampleMdFile)
+	if err != nil {
+		return err
+	}
+
+	oldPath := regexp.MustCompile(`/` + example.ParentDir() + `/` + example.Lower() + `\b`)
+	md := oldPath.ReplaceAllString(string(content), "/"+module.ParentDir()+"/"+module.Lower())
+	md = example.PromoteText(md, module)
+
+	err = os.MkdirAll(filepath.Dir(moduleMdFile), 0o755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(moduleMdFile, []byte(md), 0o644)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(exampleMdFile)
+	if err != nil {
+		return err
+	}
+
+	configFile := ctx.MkdocsConfigFile()
+
+	config, err := ReadConfig(configFile)
+	if err != nil {
+		return err
+	}
+	config.removeModule(example.IsModule, example.ParentDir()+"/"+example.Lower()+".md")
+	config.addModule(module.IsModule, module.ParentDir()+"/"+module.Lower()+".md", module.ParentDir()+"/index.md")
+	return writeConfig(configFile, config)
+}
+
 func CopyConfig(configFile string, tmpFile string) error {
 	config, err := ReadConfig(configFile)
 	if err != nil {
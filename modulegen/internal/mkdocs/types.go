@@ -77,3 +77,23 @@ func (c *Config) addModule(isModule bool, moduleMd string, indexMd string) {
 		}
 	}
 }
+
+func (c *Config) removeModule(isModule bool, moduleMd string) {
+	mkdocsNavItems := c.Nav[4].Examples
+	if isModule {
+		mkdocsNavItems = c.Nav[3].Modules
+	}
+
+	navItems := []string{}
+	for _, navItem := range mkdocsNavItems {
+		if navItem != moduleMd {
+			navItems = append(navItems, navItem)
+		}
+	}
+
+	if isModule {
+		c.Nav[3].Modules = navItems
+	} else {
+		c.Nav[4].Examples = navItems
+	}
+}
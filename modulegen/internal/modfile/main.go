@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
 )
 
 func GenerateModFile(exampleDir string, rootGoModFilePath string, directory string, tcVersion string) error {
@@ -41,3 +42,44 @@ func newModFile(moduleStmt string, goStmt string, tcPath string, tcVersion strin
 	}
 	return file, nil
 }
+
+// UpdateGoVersion raises the Go version of the go.mod file in the given directory to the one of the root go.mod file,
+// keeping the rest of the file untouched. Modules already requiring a newer Go version are not modified.
+func UpdateGoVersion(moduleDir string, rootGoModFilePath string) error {
+	rootGoMod, err := readModFile(rootGoModFilePath)
+	if err != nil {
+		return err
+	}
+	modFilePath := filepath.Join(moduleDir, "go.mod")
+	file, err := readModFile(modFilePath)
+	if err != nil {
+		return err
+	}
+	if file.Go != nil && semver.Compare("v"+file.Go.Version, "v"+rootGoMod.Go.Version) >= 0 {
+		return nil
+	}
+	err = file.AddGoStmt(rootGoMod.Go.Version)
+	if err != nil {
+		return err
+	}
+	return writeModFile(modFilePath, file)
+}
+
+// UpdateModulePath sets the module path of the go.mod file in the given directory, which is the one of the root
+// go.mod file followed by the given directory, keeping the rest of the file untouched.
+func UpdateModulePath(moduleDir string, rootGoModFilePath string, directory string) error {
+	rootGoMod, err := readModFile(rootGoModFilePath)
+	if err != nil {
+		return err
+	}
+	modFilePath := filepath.Join(moduleDir, "go.mod")
+	file, err := readModFile(modFilePath)
+	if err != nil {
+		return err
+	}
+	err = file.AddModuleStmt(rootGoMod.Module.Mod.Path + directory)
+	if err != nil {
+		return err
+	}
+	return writeModFile(modFilePath, file)
+}
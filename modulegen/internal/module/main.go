@@ -53,7 +53,7 @@ func generateGoModFile(moduleDir string, tcModule context.TestcontainersModule)
 }
 
 func GenerateFiles(moduleDir string, moduleName string, funcMap template.FuncMap, tcModule any) error {
-	templates := []string{"module_test.go", "module.go"}
+	templates := []string{"module_test.go", "module.go", "options.go", "options_test.go"}
 
 	tcModuleCtx := tcModule.(context.TestcontainersModule)
 	if tcModuleCtx.IsModule {
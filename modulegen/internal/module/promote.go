@@ -0,0 +1,158 @@
+package module
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go/modulegen/internal/context"
+	"github.com/testcontainers/testcontainers-go/modulegen/internal/modfile"
+)
+
+// PromoteModule moves the example to the modules, updating the path of its go.mod file
+// and exporting its container type and entrypoint, so they can be consumed by other packages
+func (g Generator) PromoteModule(ctx context.Context, example context.TestcontainersModule, module context.TestcontainersModule) error {
+	exampleDir := filepath.Join(ctx.RootDir, example.ParentDir(), example.Lower())
+	moduleDir := filepath.Join(ctx.RootDir, module.ParentDir(), module.Lower())
+
+	if _, err := os.Stat(exampleDir); err != nil {
+		return fmt.Errorf("the %s %s does not exist: %w", example.Type(), example.Lower(), err)
+	}
+	if _, err := os.Stat(moduleDir); err == nil {
+		return fmt.Errorf("the %s %s already exists", module.Type(), module.Lower())
+	}
+
+	err := os.MkdirAll(filepath.Dir(moduleDir), 0o755)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(exampleDir, moduleDir)
+	if err != nil {
+		return err
+	}
+
+	directory := "/" + module.ParentDir() + "/" + module.Lower()
+	err = modfile.UpdateModulePath(moduleDir, ctx.GoModFile(), directory)
+	if err != nil {
+		return err
+	}
+
+	return renameIdentifiers(moduleDir, example, module)
+}
+
+// renameIdentifiers renames the package-level identifiers of the example in the Go files of the directory, including
+// their references and the comments mentioning them, and replaces the import paths of the example with the module ones
+func renameIdentifiers(dir string, example context.TestcontainersModule, module context.TestcontainersModule) error {
+	goFiles, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	// the files are grouped by package, as the external test package is type-checked on its own
+	packages := map[string][]*ast.File{}
+	paths := map[*ast.File]string{}
+	for _, goFile := range goFiles {
+		file, err := parser.ParseFile(fset, goFile, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		packages[file.Name.Name] = append(packages[file.Name.Name], file)
+		paths[file] = goFile
+	}
+
+	for name, files := range packages {
+		info := &types.Info{
+			Defs: map[*ast.Ident]types.Object{},
+			Uses: map[*ast.Ident]types.Object{},
+		}
+		conf := types.Config{
+			// the dependencies are not needed to resolve the package-level identifiers,
+			// so the type-checking errors caused by the empty imported packages are ignored
+			Importer: emptyImporter{},
+			Error:    func(error) {},
+		}
+		pkg, _ := conf.Check(name, fset, files, info)
+
+		for _, file := range files {
+			renameFile(file, pkg, info, example, module)
+
+			var buf bytes.Buffer
+			err = format.Node(&buf, fset, file)
+			if err != nil {
+				return err
+			}
+
+			err = os.WriteFile(paths[file], buf.Bytes(), 0o644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func renameFile(file *ast.File, pkg *types.Package, info *types.Info, example context.TestcontainersModule, module context.TestcontainersModule) {
+	identifiers := example.PromotedIdentifiers(module)
+	oldDirectory := "/" + example.ParentDir() + "/" + example.Lower()
+	newDirectory := "/" + module.ParentDir() + "/" + module.Lower()
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		newName, ok := identifiers[ident.Name]
+		if !ok {
+			return true
+		}
+
+		obj := info.Defs[ident]
+		if obj == nil {
+			obj = info.Uses[ident]
+		}
+
+		// methods, fields and local variables are not declared in the package scope
+		if obj != nil && obj.Parent() == pkg.Scope() {
+			ident.Name = newName
+		}
+		return true
+	})
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			comment.Text = example.PromoteText(comment.Text, module)
+			// the doc comments start with the name of the declaration
+			for oldName, newName := range example.PromotedIdentifiers(module) {
+				comment.Text = regexp.MustCompile(`^// `+oldName+`\b`).ReplaceAllString(comment.Text, "// "+newName)
+			}
+		}
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err == nil && strings.HasSuffix(path, oldDirectory) {
+			spec.Path.Value = strconv.Quote(strings.TrimSuffix(path, oldDirectory) + newDirectory)
+		}
+	}
+}
+
+// emptyImporter returns empty packages, named after the last element of their import path
+type emptyImporter struct{}
+
+func (emptyImporter) Import(path string) (*types.Package, error) {
+	pkg := types.NewPackage(path, filepath.Base(path))
+	pkg.MarkComplete()
+	return pkg, nil
+}
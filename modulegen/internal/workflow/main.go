@@ -12,15 +12,11 @@ type Generator struct{}
 
 // Generate updates github ci workflow
 func (g Generator) Generate(ctx context.Context) error {
-	rootCtx, err := context.GetRootContext()
+	examples, err := ctx.GetExamples()
 	if err != nil {
 		return err
 	}
-	examples, err := rootCtx.GetExamples()
-	if err != nil {
-		return err
-	}
-	modules, err := rootCtx.GetModules()
+	modules, err := ctx.GetModules()
 	if err != nil {
 		return err
 	}
@@ -33,7 +33,7 @@ func TestModule(t *testing.T) {
 				TitleName: "MongoDB",
 			},
 			expectedContainerName: "MongoDBContainer",
-			expectedEntrypoint:    "RunContainer",
+			expectedEntrypoint:    "Run",
 			expectedTitle:         "MongoDB",
 		},
 		{
@@ -44,7 +44,7 @@ func TestModule(t *testing.T) {
 				Image:    "mongodb:latest",
 			},
 			expectedContainerName: "MongodbContainer",
-			expectedEntrypoint:    "RunContainer",
+			expectedEntrypoint:    "Run",
 			expectedTitle:         "Mongodb",
 		},
 		{
@@ -56,7 +56,7 @@ func TestModule(t *testing.T) {
 				TitleName: "MongoDB",
 			},
 			expectedContainerName: "mongoDBContainer",
-			expectedEntrypoint:    "runContainer",
+			expectedEntrypoint:    "run",
 			expectedTitle:         "MongoDB",
 		},
 		{
@@ -67,7 +67,7 @@ func TestModule(t *testing.T) {
 				Image:    "mongodb:latest",
 			},
 			expectedContainerName: "mongodbContainer",
-			expectedEntrypoint:    "runContainer",
+			expectedEntrypoint:    "run",
 			expectedTitle:         "Mongodb",
 		},
 	}
@@ -290,12 +290,14 @@ func TestGenerate(t *testing.T) {
 	require.NoError(t, err) // error nil implies the file exist
 
 	assertModuleDocContent(t, module, moduleDocFile)
-	assertModuleGithubWorkflowContent(t, module, mainWorkflowFile)
+	assertModuleGithubWorkflowContent(t, module, tmpCtx, mainWorkflowFile)
 
 	generatedTemplatesDir := filepath.Join(examplesTmp, moduleNameLower)
 	// do not generate examples_test.go for examples
 	assertModuleTestContent(t, module, filepath.Join(generatedTemplatesDir, moduleNameLower+"_test.go"))
 	assertModuleContent(t, module, filepath.Join(generatedTemplatesDir, moduleNameLower+".go"))
+	assertOptionsContent(t, module, filepath.Join(generatedTemplatesDir, "options.go"))
+	assertOptionsTestContent(t, module, filepath.Join(generatedTemplatesDir, "options_test.go"))
 	assertGoModContent(t, module, originalConfig.Extra.LatestVersion, filepath.Join(generatedTemplatesDir, "go.mod"))
 	assertMakefileContent(t, module, filepath.Join(generatedTemplatesDir, "Makefile"))
 	assertMkdocsNavItems(t, module, originalConfig, tmpCtx)
@@ -353,18 +355,197 @@ func TestGenerateModule(t *testing.T) {
 	require.NoError(t, err) // error nil implies the file exist
 
 	assertModuleDocContent(t, module, moduleDocFile)
-	assertModuleGithubWorkflowContent(t, module, mainWorkflowFile)
+	assertModuleGithubWorkflowContent(t, module, tmpCtx, mainWorkflowFile)
 
 	generatedTemplatesDir := filepath.Join(modulesTmp, moduleNameLower)
 	assertExamplesTestContent(t, module, filepath.Join(generatedTemplatesDir, "examples_test.go"))
 	assertModuleTestContent(t, module, filepath.Join(generatedTemplatesDir, moduleNameLower+"_test.go"))
 	assertModuleContent(t, module, filepath.Join(generatedTemplatesDir, moduleNameLower+".go"))
+	assertOptionsContent(t, module, filepath.Join(generatedTemplatesDir, "options.go"))
+	assertOptionsTestContent(t, module, filepath.Join(generatedTemplatesDir, "options_test.go"))
 	assertGoModContent(t, module, originalConfig.Extra.LatestVersion, filepath.Join(generatedTemplatesDir, "go.mod"))
 	assertMakefileContent(t, module, filepath.Join(generatedTemplatesDir, "Makefile"))
 	assertMkdocsNavItems(t, module, originalConfig, tmpCtx)
 	assertDependabotUpdates(t, module, originalDependabotConfigUpdates, tmpCtx)
 }
 
+func TestRefresh(t *testing.T) {
+	tmpCtx := context.New(t.TempDir())
+	rootCtx := getTestRootContext(t)
+
+	err := os.MkdirAll(tmpCtx.GithubWorkflowsDir(), 0o777)
+	require.NoError(t, err)
+
+	err = copyInitialMkdocsConfig(t, tmpCtx)
+	require.NoError(t, err)
+
+	err = copyInitialDependabotConfig(t, tmpCtx)
+	require.NoError(t, err)
+
+	rootGoMod, err := os.ReadFile(rootCtx.GoModFile())
+	require.NoError(t, err)
+	err = os.WriteFile(tmpCtx.GoModFile(), rootGoMod, 0o644)
+	require.NoError(t, err)
+
+	goStmt := ""
+	for _, line := range sanitiseContent(rootGoMod) {
+		if strings.HasPrefix(line, "go ") {
+			goStmt = line
+		}
+	}
+	require.NotEmpty(t, goStmt)
+
+	module := context.TestcontainersModule{Name: "foodb", IsModule: true}
+	example := context.TestcontainersModule{Name: "bardb", IsModule: false}
+
+	// the Go version of the module is raised, while the newer one of the example is kept
+	goVersions := map[string]string{module.Lower(): "1.19", example.Lower(): "9.99"}
+	expectedGoStmts := map[string]string{module.Lower(): goStmt, example.Lower(): "go 9.99"}
+
+	code := "package foodb\n\n// hand-written code\n"
+	for _, tcModule := range []context.TestcontainersModule{module, example} {
+		moduleDir := filepath.Join(tmpCtx.RootDir, tcModule.ParentDir(), tcModule.Lower())
+		err = os.MkdirAll(moduleDir, 0o777)
+		require.NoError(t, err)
+
+		goMod := "module github.com/testcontainers/testcontainers-go/" + tcModule.ParentDir() + "/" + tcModule.Lower() + "\n\n" +
+			"go " + goVersions[tcModule.Lower()] + "\n\n" +
+			"require github.com/testcontainers/testcontainers-go v0.1.0\n"
+		err = os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte(goMod), 0o644)
+		require.NoError(t, err)
+
+		err = os.WriteFile(filepath.Join(moduleDir, "Makefile"), []byte("test:\n\tgo test ./...\n"), 0o644)
+		require.NoError(t, err)
+
+		err = os.WriteFile(filepath.Join(moduleDir, tcModule.Lower()+".go"), []byte(code), 0o644)
+		require.NoError(t, err)
+	}
+
+	err = internal.RefreshFiles(tmpCtx)
+	require.NoError(t, err)
+
+	updates, err := dependabot.GetUpdates(tmpCtx.DependabotConfigFile())
+	require.NoError(t, err)
+
+	for _, tcModule := range []context.TestcontainersModule{module, example} {
+		moduleDir := filepath.Join(tmpCtx.RootDir, tcModule.ParentDir(), tcModule.Lower())
+
+		assertMakefileContent(t, tcModule, filepath.Join(moduleDir, "Makefile"))
+
+		content, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+		require.NoError(t, err)
+		data := sanitiseContent(content)
+		assert.Equal(t, "module github.com/testcontainers/testcontainers-go/"+tcModule.ParentDir()+"/"+tcModule.Lower(), data[0])
+		assert.Equal(t, expectedGoStmts[tcModule.Lower()], data[2])
+		assert.Equal(t, "require github.com/testcontainers/testcontainers-go v0.1.0", data[4])
+
+		content, err = os.ReadFile(filepath.Join(moduleDir, tcModule.Lower()+".go"))
+		require.NoError(t, err)
+		assert.Equal(t, code, string(content))
+
+		directory := "/" + tcModule.ParentDir() + "/" + tcModule.Lower()
+		found := false
+		for _, update := range updates {
+			if update.Directory == directory {
+				found = true
+			}
+		}
+		assert.True(t, found, "missing dependabot update for %s", directory)
+	}
+
+	mainWorkflowFile := filepath.Join(tmpCtx.GithubWorkflowsDir(), "ci.yml")
+	content, err := os.ReadFile(mainWorkflowFile)
+	require.NoError(t, err)
+	data := sanitiseContent(content)
+	assert.Equal(t, "        module: [foodb]", data[106])
+	assert.Equal(t, "        module: [bardb]", data[126])
+}
+
+func TestPromote(t *testing.T) {
+	tmpCtx := context.New(t.TempDir())
+	rootCtx := getTestRootContext(t)
+
+	err := os.MkdirAll(filepath.Join(tmpCtx.RootDir, "examples"), 0o777)
+	require.NoError(t, err)
+	err = os.MkdirAll(filepath.Join(tmpCtx.RootDir, "modules"), 0o777)
+	require.NoError(t, err)
+	err = os.MkdirAll(tmpCtx.GithubWorkflowsDir(), 0o777)
+	require.NoError(t, err)
+
+	err = copyInitialMkdocsConfig(t, tmpCtx)
+	require.NoError(t, err)
+
+	originalConfig, err := mkdocs.ReadConfig(tmpCtx.MkdocsConfigFile())
+	require.NoError(t, err)
+
+	err = copyInitialDependabotConfig(t, tmpCtx)
+	require.NoError(t, err)
+
+	originalDependabotConfigUpdates, err := dependabot.GetUpdates(tmpCtx.DependabotConfigFile())
+	require.NoError(t, err)
+
+	rootGoMod, err := os.ReadFile(rootCtx.GoModFile())
+	require.NoError(t, err)
+	err = os.WriteFile(tmpCtx.GoModFile(), rootGoMod, 0o644)
+	require.NoError(t, err)
+
+	example := context.TestcontainersModule{
+		Name:      "foodb",
+		TitleName: "FooDB",
+		IsModule:  false,
+		Image:     "docker.io/example/foodb:latest",
+	}
+	module := context.TestcontainersModule{
+		Name:      "foodb",
+		TitleName: "FooDB",
+		IsModule:  true,
+		Image:     "docker.io/example/foodb:latest",
+	}
+
+	err = internal.GenerateFiles(tmpCtx, example)
+	require.NoError(t, err)
+
+	exampleDir := filepath.Join(tmpCtx.RootDir, example.ParentDir(), example.Lower())
+	moduleDir := filepath.Join(tmpCtx.RootDir, module.ParentDir(), module.Lower())
+
+	// the methods and the local variables named after the entrypoint must not be renamed
+	code := "package foodb\n\nfunc (c *fooDBContainer) run() {}\n\nfunc start() {\n\trun := 1\n\t_ = run\n}\n"
+	err = os.WriteFile(filepath.Join(exampleDir, "start.go"), []byte(code), 0o644)
+	require.NoError(t, err)
+
+	err = internal.PromoteFiles(tmpCtx, example, module)
+	require.NoError(t, err)
+
+	_, err = os.Stat(exampleDir)
+	require.True(t, os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(tmpCtx.DocsDir(), example.ParentDir(), example.Lower()+".md"))
+	require.True(t, os.IsNotExist(err))
+
+	assertModuleDocContent(t, module, filepath.Join(tmpCtx.DocsDir(), module.ParentDir(), module.Lower()+".md"))
+	assertModuleTestContent(t, module, filepath.Join(moduleDir, module.Lower()+"_test.go"))
+	assertModuleContent(t, module, filepath.Join(moduleDir, module.Lower()+".go"))
+	assertGoModContent(t, module, originalConfig.Extra.LatestVersion, filepath.Join(moduleDir, "go.mod"))
+	assertMakefileContent(t, module, filepath.Join(moduleDir, "Makefile"))
+	assertMkdocsNavItems(t, module, originalConfig, tmpCtx)
+	assertDependabotUpdates(t, module, originalDependabotConfigUpdates, tmpCtx)
+
+	content, err := os.ReadFile(filepath.Join(moduleDir, "start.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package foodb\n\nfunc (c *FooDBContainer) run() {}\n\nfunc start() {\n\trun := 1\n\t_ = run\n}\n", string(content))
+
+	// the example is not in the nav nor in the dependabot updates anymore
+	config, err := mkdocs.ReadConfig(tmpCtx.MkdocsConfigFile())
+	require.NoError(t, err)
+	assert.Equal(t, originalConfig.Nav[4].Examples, config.Nav[4].Examples)
+
+	updates, err := dependabot.GetUpdates(tmpCtx.DependabotConfigFile())
+	require.NoError(t, err)
+	for _, update := range updates {
+		assert.NotEqual(t, "/"+example.ParentDir()+"/"+example.Lower(), update.Directory)
+	}
+}
+
 // assert content in the Dependabot descriptor file
 func assertDependabotUpdates(t *testing.T, module context.TestcontainersModule, originalConfigUpdates dependabot.Updates, tmpCtx context.Context) {
 	modules, err := dependabot.GetUpdates(tmpCtx.DependabotConfigFile())
@@ -415,9 +596,10 @@ func assertModuleDocContent(t *testing.T, module context.TestcontainersModule, m
 	assert.Equal(t, "<!--codeinclude-->", data[18])
 	assert.Equal(t, data[19], "[Creating a "+title+" container](../../"+module.ParentDir()+"/"+lower+"/examples_test.go) inside_block:run"+title+"Container")
 	assert.Equal(t, "<!--/codeinclude-->", data[20])
-	assert.Equal(t, data[24], "The "+title+" module exposes one entrypoint function to create the "+title+" container, and this function receives two parameters:")
-	assert.True(t, strings.HasSuffix(data[27], "(*"+title+"Container, error)"))
-	assert.Equal(t, "for "+title+". E.g. `testcontainers.WithImage(\""+module.Image+"\")`.", data[40])
+	assert.Equal(t, data[24], "The "+title+" module exposes one entrypoint function to create the "+title+" container, and this function receives three parameters:")
+	assert.Equal(t, data[27], "func "+module.Entrypoint()+"(ctx context.Context, img string, opts ...testcontainers.ContainerCustomizer) (*"+title+"Container, error)")
+	assert.Equal(t, "- `string`, the Docker image to use.", data[31])
+	assert.Equal(t, "for "+title+". E.g. `"+module.Entrypoint()+"(context.Background(), \""+module.Image+"\")`.", data[41])
 }
 
 // assert content module test
@@ -431,14 +613,13 @@ func assertExamplesTestContent(t *testing.T, module context.TestcontainersModule
 
 	data := sanitiseContent(content)
 	assert.Equal(t, data[0], "package "+lower+"_test")
-	assert.Equal(t, "\t\"github.com/testcontainers/testcontainers-go\"", data[6])
-	assert.Equal(t, data[7], "\t\"github.com/testcontainers/testcontainers-go/modules/"+lower+"\"")
-	assert.Equal(t, data[10], "func Example"+entrypoint+"() {")
-	assert.Equal(t, data[11], "\t// run"+title+"Container {")
-	assert.Equal(t, data[14], "\t"+lower+"Container, err := "+lower+"."+entrypoint+"(ctx, testcontainers.WithImage(\""+module.Image+"\"))")
-	assert.Equal(t, "\tfmt.Println(state.Running)", data[32])
-	assert.Equal(t, "\t// Output:", data[34])
-	assert.Equal(t, "\t// true", data[35])
+	assert.Equal(t, data[6], "\t\"github.com/testcontainers/testcontainers-go/modules/"+lower+"\"")
+	assert.Equal(t, data[9], "func Example"+entrypoint+"() {")
+	assert.Equal(t, data[10], "\t// run"+title+"Container {")
+	assert.Equal(t, data[13], "\t"+lower+"Container, err := "+lower+"."+entrypoint+"(ctx, \""+module.Image+"\")")
+	assert.Equal(t, "\tfmt.Println(state.Running)", data[31])
+	assert.Equal(t, "\t// Output:", data[33])
+	assert.Equal(t, "\t// true", data[34])
 }
 
 // assert content module test
@@ -448,8 +629,8 @@ func assertModuleTestContent(t *testing.T, module context.TestcontainersModule,
 
 	data := sanitiseContent(content)
 	assert.Equal(t, data[0], "package "+module.Lower())
-	assert.Equal(t, data[9], "func Test"+module.Title()+"(t *testing.T) {")
-	assert.Equal(t, data[12], "\tcontainer, err := "+module.Entrypoint()+"(ctx, testcontainers.WithImage(\""+module.Image+"\"))")
+	assert.Equal(t, data[7], "func Test"+module.Title()+"(t *testing.T) {")
+	assert.Equal(t, data[10], "\tcontainer, err := "+module.Entrypoint()+"(ctx, \""+module.Image+"\")")
 }
 
 // assert content module
@@ -466,19 +647,45 @@ func assertModuleContent(t *testing.T, module context.TestcontainersModule, exam
 	assert.Equal(t, data[0], "package "+lower)
 	assert.Equal(t, data[8], "// "+containerName+" represents the "+exampleName+" container type used in the module")
 	assert.Equal(t, data[9], "type "+containerName+" struct {")
-	assert.Equal(t, data[13], "// "+entrypoint+" creates an instance of the "+exampleName+" container type")
-	assert.Equal(t, data[14], "func "+entrypoint+"(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*"+containerName+", error) {")
-	assert.Equal(t, data[16], "\t\tImage: \""+module.Image+"\",")
-	assert.Equal(t, data[33], "\treturn &"+containerName+"{Container: container}, nil")
+	assert.Equal(t, data[13], "// "+entrypoint+" creates an instance of the "+exampleName+" container type, using the given Docker image")
+	assert.Equal(t, data[14], "func "+entrypoint+"(ctx context.Context, img string, opts ...testcontainers.ContainerCustomizer) (*"+containerName+", error) {")
+	assert.Equal(t, data[16], "\t\tImage: img,")
+	assert.Equal(t, data[24], "\tsettings := defaultOptions()")
+	assert.Equal(t, data[37], "\treturn &"+containerName+"{Container: container}, nil")
+}
+
+// assert content options
+func assertOptionsContent(t *testing.T, module context.TestcontainersModule, optionsFile string) {
+	content, err := os.ReadFile(optionsFile)
+	require.NoError(t, err)
+
+	data := sanitiseContent(content)
+	assert.Equal(t, data[0], "package "+module.Lower())
+	assert.Equal(t, "type options struct {", data[6])
+	assert.Equal(t, "func defaultOptions() options {", data[10])
+	assert.Equal(t, "var _ testcontainers.ContainerCustomizer = (*Option)(nil)", data[15])
+	assert.Equal(t, data[17], "// Option is an option for the "+module.Title()+" container.")
+	assert.Equal(t, "type Option func(*options)", data[18])
+	assert.Equal(t, "func (o Option) Customize(*testcontainers.GenericContainerRequest) {", data[21])
+}
+
+// assert content options test
+func assertOptionsTestContent(t *testing.T, module context.TestcontainersModule, optionsTestFile string) {
+	content, err := os.ReadFile(optionsTestFile)
+	require.NoError(t, err)
+
+	data := sanitiseContent(content)
+	assert.Equal(t, data[0], "package "+module.Lower())
+	assert.Equal(t, "func TestOptions(t *testing.T) {", data[7])
+	assert.Equal(t, "\t\t\tsettings := defaultOptions()", data[22])
 }
 
 // assert content GitHub workflow for the module
-func assertModuleGithubWorkflowContent(t *testing.T, module context.TestcontainersModule, moduleWorkflowFile string) {
+func assertModuleGithubWorkflowContent(t *testing.T, module context.TestcontainersModule, ctx context.Context, moduleWorkflowFile string) {
 	content, err := os.ReadFile(moduleWorkflowFile)
 	require.NoError(t, err)
 
 	data := sanitiseContent(content)
-	ctx := getTestRootContext(t)
 
 	modulesList, err := ctx.GetModules()
 	require.NoError(t, err)
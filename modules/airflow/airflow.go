@@ -0,0 +1,245 @@
+package airflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "8080/tcp"
+
+	dagsFolder = "/opt/airflow/dags"
+
+	// adminPasswordPath is the file where the standalone command writes the generated password of the admin account.
+	adminPasswordPath = "/opt/airflow/standalone_admin_password.txt"
+)
+
+// AdminUser is the user of the admin account created by the standalone command.
+const AdminUser = "admin"
+
+// AirflowContainer represents the Airflow container type used in the module
+type AirflowContainer struct {
+	testcontainers.Container
+	adminPassword string
+}
+
+// RunContainer creates an instance of the Airflow container type, running the standalone command, which
+// initialises a SQLite database, creates the admin account and starts all the components. The examples
+// are not loaded, the DAGs are not paused when they are created, and the REST API accepts the basic
+// authentication, so the DAGs can be triggered by the tests. The generated password of the admin account
+// is read from the filesystem of the container.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*AirflowContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apache/airflow:2.8.1",
+		ExposedPorts: []string{httpPort},
+		Cmd:          []string{"standalone"},
+		Env: map[string]string{
+			"AIRFLOW__CORE__LOAD_EXAMPLES":                  "False",
+			"AIRFLOW__CORE__DAGS_ARE_PAUSED_AT_CREATION":    "False",
+			"AIRFLOW__API__AUTH_BACKENDS":                   "airflow.api.auth.backend.basic_auth,airflow.api.auth.backend.session",
+			"AIRFLOW__SCHEDULER__DAG_DIR_LIST_INTERVAL":     "5",
+			"AIRFLOW__SCHEDULER__MIN_FILE_PROCESS_INTERVAL": "5",
+		},
+		WaitingFor: wait.ForHTTP("/health").
+			WithPort(httpPort).
+			WithResponseMatcher(schedulerHealthy).
+			WithStartupTimeout(3 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.DAGsFolder != "" {
+		hostDir, err := filepath.Abs(settings.DAGsFolder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the DAGs folder: %w", err)
+		}
+
+		hostConfigModifier := genericContainerReq.HostConfigModifier
+		genericContainerReq.HostConfigModifier = func(hostConfig *container.HostConfig) {
+			if hostConfigModifier != nil {
+				hostConfigModifier(hostConfig)
+			}
+			hostConfig.Binds = append(hostConfig.Binds, hostDir+":"+dagsFolder)
+		}
+	}
+
+	var adminPassword string
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				var err error
+				adminPassword, err = readAdminPassword(ctx, c)
+				return err
+			},
+		},
+	})
+
+	c, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AirflowContainer{Container: c, adminPassword: adminPassword}, nil
+}
+
+// schedulerHealthy reports whether the health of the webserver reports a healthy scheduler.
+func schedulerHealthy(body io.Reader) bool {
+	var health struct {
+		Scheduler struct {
+			Status string `json:"status"`
+		} `json:"scheduler"`
+	}
+	if err := json.NewDecoder(body).Decode(&health); err != nil {
+		return false
+	}
+
+	return health.Scheduler.Status == "healthy"
+}
+
+// readAdminPassword reads the generated password of the admin account.
+func readAdminPassword(ctx context.Context, c testcontainers.Container) (string, error) {
+	reader, err := c.CopyFileFromContainer(ctx, adminPasswordPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the admin password: %w", err)
+	}
+	defer reader.Close()
+
+	password, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the admin password: %w", err)
+	}
+
+	return strings.TrimSpace(string(password)), nil
+}
+
+// AdminPassword returns the password of the admin account, generated by the standalone command.
+func (c *AirflowContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// URL returns the base URL of the webserver, e.g. "http://localhost:32768".
+func (c *AirflowContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// APIURL returns the base URL of the stable REST API, e.g. "http://localhost:32768/api/v1",
+// which accepts the basic authentication with the admin account.
+func (c *AirflowContainer) APIURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v1", nil
+}
+
+// TriggerDAG creates a run of the DAG with the given ID, passing the given configuration to the run,
+// and returns the ID of the run. The DAG must have been loaded by the scheduler.
+func (c *AirflowContainer) TriggerDAG(ctx context.Context, dagID string, conf map[string]interface{}) (string, error) {
+	if conf == nil {
+		conf = map[string]interface{}{}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"conf": conf})
+	if err != nil {
+		return "", err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/dags/"+url.PathEscape(dagID)+"/dagRuns", body)
+	if err != nil {
+		return "", err
+	}
+
+	var run struct {
+		DAGRunID string `json:"dag_run_id"`
+	}
+	if err := json.Unmarshal(respBody, &run); err != nil {
+		return "", err
+	}
+
+	return run.DAGRunID, nil
+}
+
+// DAGRunState returns the state of the given run of a DAG, e.g. "queued", "running", "success" or "failed".
+func (c *AirflowContainer) DAGRunState(ctx context.Context, dagID string, runID string) (string, error) {
+	respBody, err := c.do(ctx, http.MethodGet, "/dags/"+url.PathEscape(dagID)+"/dagRuns/"+url.PathEscape(runID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var run struct {
+		State string `json:"state"`
+	}
+	if err := json.Unmarshal(respBody, &run); err != nil {
+		return "", err
+	}
+
+	return run.State, nil
+}
+
+func (c *AirflowContainer) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	apiURL, err := c.APIURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, apiURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(AdminUser, c.adminPassword)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
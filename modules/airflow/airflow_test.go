@@ -0,0 +1,91 @@
+package airflow_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/airflow"
+)
+
+func TestAirflow(t *testing.T) {
+	ctx := context.Background()
+
+	// withDAGsFolder {
+	container, err := airflow.RunContainer(ctx, airflow.WithDAGsFolder("testdata"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// apiURL {
+	apiURL, err := container.APIURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, apiURL+"/dags", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// adminPassword {
+	req.SetBasicAuth(airflow.AdminUser, container.AdminPassword())
+	// }
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the DAGs", resp.StatusCode)
+	}
+
+	// the DAG can be triggered once it's loaded by the scheduler
+	var runID string
+	deadline := time.Now().Add(time.Minute)
+	for {
+		// triggerDAG {
+		runID, err = container.TriggerDAG(ctx, "hello", map[string]interface{}{"name": "testcontainers"})
+		// }
+		if err == nil {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("failed to trigger the DAG: %s", err)
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	deadline = time.Now().Add(2 * time.Minute)
+	for {
+		// dagRunState {
+		state, err := container.DAGRunState(ctx, "hello", runID)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if state == "success" {
+			break
+		}
+
+		if state == "failed" || time.Now().After(deadline) {
+			t.Fatalf("expected the DAG run to succeed, got %s", state)
+		}
+
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,37 @@
+package airflow_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/airflow"
+)
+
+func ExampleRunContainer() {
+	// runAirflowContainer {
+	ctx := context.Background()
+
+	airflowContainer, err := airflow.RunContainer(ctx, testcontainers.WithImage("apache/airflow:2.8.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := airflowContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := airflowContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package airflow
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// DAGsFolder is the host directory mounted as the DAGs folder.
+	DAGsFolder string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Airflow container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithDAGsFolder mounts the given host directory as the DAGs folder, so the DAGs of the project are loaded
+// by the scheduler, and the changes to their files are picked up without restarting the container.
+// The directory and its files must be readable by the user of the container, whose uid is 50000.
+func WithDAGsFolder(hostDir string) Option {
+	return func(o *options) {
+		o.DAGsFolder = hostDir
+	}
+}
@@ -0,0 +1,7 @@
+from datetime import datetime
+
+from airflow import DAG
+from airflow.operators.bash import BashOperator
+
+with DAG(dag_id="hello", start_date=datetime(2024, 1, 1), schedule=None, catchup=False):
+    BashOperator(task_id="greet", bash_command='echo "hello {{ dag_run.conf.get(\'name\', \'world\') }}"')
@@ -0,0 +1,180 @@
+package azurite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	// AccountName is the name of the well-known development account of Azurite.
+	AccountName = "devstoreaccount1"
+	// AccountKey is the key of the well-known development account of Azurite.
+	AccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// Service is one of the Azure Storage services emulated by Azurite.
+type Service string
+
+const (
+	BlobService  Service = "blob"
+	QueueService Service = "queue"
+	TableService Service = "table"
+)
+
+// servicePorts maps each service to its default port.
+var servicePorts = map[Service]nat.Port{
+	BlobService:  "10000/tcp",
+	QueueService: "10001/tcp",
+	TableService: "10002/tcp",
+}
+
+// AzuriteContainer represents the Azurite container type used in the module
+type AzuriteContainer struct {
+	testcontainers.Container
+	enabledServices []Service
+}
+
+// RunContainer creates an instance of the Azurite container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*AzuriteContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image: "mcr.microsoft.com/azure-storage/azurite:3.28.0",
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if len(settings.EnabledServices) == 0 {
+		return nil, fmt.Errorf("at least one service must be enabled")
+	}
+
+	strategies := make([]wait.Strategy, 0, len(settings.EnabledServices))
+	for _, srv := range settings.EnabledServices {
+		port, ok := servicePorts[srv]
+		if !ok {
+			return nil, fmt.Errorf("unknown service: %s", srv)
+		}
+
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, string(port))
+		strategies = append(strategies, wait.ForLog(fmt.Sprintf("Azurite %s service is successfully listening", serviceTitle(srv))))
+	}
+
+	if genericContainerReq.WaitingFor == nil {
+		genericContainerReq.WaitingFor = wait.ForAll(strategies...)
+	}
+
+	if len(genericContainerReq.Cmd) == 0 {
+		genericContainerReq.Cmd = command(settings.EnabledServices)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzuriteContainer{Container: container, enabledServices: settings.EnabledServices}, nil
+}
+
+// command returns the command starting the given services, listening on all the interfaces.
+// The azurite executable starts all the services, while each service has its own executable,
+// so a shell is only needed to start a subset of two services.
+func command(services []Service) []string {
+	hostArgs := func(srv Service) []string {
+		return []string{fmt.Sprintf("--%sHost", srv), "0.0.0.0"}
+	}
+
+	switch len(services) {
+	case len(servicePorts):
+		cmd := []string{"azurite"}
+		for _, srv := range services {
+			cmd = append(cmd, hostArgs(srv)...)
+		}
+		return append(cmd, "--skipApiVersionCheck")
+	case 1:
+		cmd := append([]string{"azurite-" + string(services[0])}, hostArgs(services[0])...)
+		return append(cmd, "--skipApiVersionCheck")
+	}
+
+	commands := make([]string, 0, len(services))
+	for _, srv := range services {
+		commands = append(commands, fmt.Sprintf("azurite-%s %s --skipApiVersionCheck", srv, strings.Join(hostArgs(srv), " ")))
+	}
+
+	return []string{"sh", "-c", strings.Join(commands, " & ") + " & wait"}
+}
+
+// serviceTitle returns the name of the service as it appears in the Azurite logs, e.g. "Blob".
+func serviceTitle(srv Service) string {
+	s := string(srv)
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// ServiceURL returns the URL of the given service for the well-known development account,
+// in the format http://host:port/devstoreaccount1, to be used with the Azure SDK clients.
+func (c *AzuriteContainer) ServiceURL(ctx context.Context, srv Service) (string, error) {
+	port, ok := servicePorts[srv]
+	if !ok {
+		return "", fmt.Errorf("unknown service: %s", srv)
+	}
+
+	if !c.isEnabled(srv) {
+		return "", fmt.Errorf("the %s service is not enabled", srv)
+	}
+
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s/%s", host, mappedPort.Port(), AccountName), nil
+}
+
+// ConnectionString returns the connection string for the well-known development account,
+// including the endpoints of the enabled services.
+func (c *AzuriteContainer) ConnectionString(ctx context.Context) (string, error) {
+	parts := []string{
+		"DefaultEndpointsProtocol=http",
+		"AccountName=" + AccountName,
+		"AccountKey=" + AccountKey,
+	}
+
+	for _, srv := range c.enabledServices {
+		url, err := c.ServiceURL(ctx, srv)
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, fmt.Sprintf("%sEndpoint=%s", serviceTitle(srv), url))
+	}
+
+	return strings.Join(parts, ";") + ";", nil
+}
+
+func (c *AzuriteContainer) isEnabled(srv Service) bool {
+	for _, s := range c.enabledServices {
+		if s == srv {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package azurite
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestAzurite(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("mcr.microsoft.com/azure-storage/azurite:3.28.0"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx)
+	// }
+	require.NoError(t, err)
+	assert.Contains(t, connStr, "BlobEndpoint=")
+	assert.Contains(t, connStr, "QueueEndpoint=")
+	assert.Contains(t, connStr, "TableEndpoint=")
+
+	client, err := azblob.NewClientFromConnectionString(connStr, nil)
+	require.NoError(t, err)
+
+	_, err = client.CreateContainer(ctx, "testcontainers", nil)
+	require.NoError(t, err)
+
+	_, err = client.UploadBuffer(ctx, "testcontainers", "hello.txt", []byte("hello world"), nil)
+	require.NoError(t, err)
+
+	// serviceURL {
+	blobURL, err := container.ServiceURL(ctx, BlobService)
+	// }
+	require.NoError(t, err)
+
+	cred, err := azblob.NewSharedKeyCredential(AccountName, AccountKey)
+	require.NoError(t, err)
+
+	client, err = azblob.NewClientWithSharedKeyCredential(blobURL, cred, nil)
+	require.NoError(t, err)
+
+	pager := client.NewListBlobsFlatPager("testcontainers", nil)
+	resp, err := pager.NextPage(ctx)
+	require.NoError(t, err)
+	require.Len(t, resp.Segment.BlobItems, 1)
+	assert.Equal(t, "hello.txt", *resp.Segment.BlobItems[0].Name)
+}
+
+func TestAzuriteWithEnabledServices(t *testing.T) {
+	ctx := context.Background()
+
+	// withEnabledServices {
+	container, err := RunContainer(ctx, WithEnabledServices(QueueService, TableService))
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	_, err = container.ServiceURL(ctx, BlobService)
+	require.Error(t, err)
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, connStr, "BlobEndpoint=")
+
+	queueURL, err := container.ServiceURL(ctx, QueueService)
+	require.NoError(t, err)
+
+	// the queue service answers, even if the request is not authenticated
+	resp, err := http.Get(queueURL + "?comp=list")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestCommand(t *testing.T) {
+	t.Run("all services", func(t *testing.T) {
+		cmd := command([]Service{BlobService, QueueService, TableService})
+		assert.Equal(t, "azurite", cmd[0])
+		assert.Contains(t, cmd, "--blobHost")
+		assert.Contains(t, cmd, "--queueHost")
+		assert.Contains(t, cmd, "--tableHost")
+	})
+
+	t.Run("one service", func(t *testing.T) {
+		cmd := command([]Service{TableService})
+		assert.Equal(t, []string{"azurite-table", "--tableHost", "0.0.0.0", "--skipApiVersionCheck"}, cmd)
+	})
+
+	t.Run("two services", func(t *testing.T) {
+		cmd := command([]Service{BlobService, QueueService})
+		require.Len(t, cmd, 3)
+		assert.Equal(t, []string{"sh", "-c"}, cmd[:2])
+		assert.True(t, strings.HasPrefix(cmd[2], "azurite-blob --blobHost 0.0.0.0"))
+		assert.Contains(t, cmd[2], "& azurite-queue --queueHost 0.0.0.0")
+	})
+}
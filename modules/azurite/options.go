@@ -0,0 +1,35 @@
+package azurite
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// EnabledServices is the list of services to be started, in the order they were enabled.
+	EnabledServices []Service
+}
+
+func defaultOptions() options {
+	return options{
+		EnabledServices: []Service{BlobService, QueueService, TableService},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Azurite container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithEnabledServices starts only the given services, instead of the blob, queue and table services,
+// which are started by default. Only the ports of the enabled services are exposed.
+func WithEnabledServices(services ...Service) Option {
+	return func(o *options) {
+		o.EnabledServices = services
+	}
+}
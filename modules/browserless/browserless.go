@@ -0,0 +1,163 @@
+package browserless
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "3000/tcp"
+
+// BrowserlessContainer represents the Browserless container type used in the module
+type BrowserlessContainer struct {
+	testcontainers.Container
+	token string
+}
+
+// RunContainer creates an instance of the Browserless container type, running headless Chrome behind the
+// browserless server, which exposes the WebSocket endpoints of the Chrome DevTools Protocol and of Playwright,
+// so the headless browser tests can run without installing browsers on the host.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*BrowserlessContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "browserless/chrome:1.61.1-puppeteer-21.4.1",
+		ExposedPorts: []string{httpPort},
+		Env:          map[string]string{},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.Token != "" {
+		genericContainerReq.Env["TOKEN"] = settings.Token
+	}
+
+	if genericContainerReq.WaitingFor == nil {
+		genericContainerReq.WaitingFor = wait.ForHTTP("/pressure" + tokenQuery(settings.Token)).WithPort(httpPort)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BrowserlessContainer{Container: container, token: settings.Token}, nil
+}
+
+// tokenQuery returns the query string of the given token, if any.
+func tokenQuery(token string) string {
+	if token == "" {
+		return ""
+	}
+
+	return "?" + url.Values{"token": {token}}.Encode()
+}
+
+// WebSocketURL returns the URL of the WebSocket endpoint of the Chrome DevTools Protocol, e.g.
+// "ws://localhost:32768", to be used as the browser WebSocket endpoint of Puppeteer, as the remote
+// allocator URL of chromedp, or with the connectOverCDP method of Playwright. Each connection
+// starts a new browser.
+func (c *BrowserlessContainer) WebSocketURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return "ws://" + address + tokenQuery(c.token), nil
+}
+
+// PlaywrightURL returns the URL of the WebSocket endpoint of Playwright, e.g. "ws://localhost:32768/playwright",
+// to be used with the connect method of the Chromium browser type of Playwright.
+func (c *BrowserlessContainer) PlaywrightURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return "ws://" + address + "/playwright" + tokenQuery(c.token), nil
+}
+
+// URL returns the base URL of the HTTP API, e.g. "http://localhost:32768", serving the REST APIs of
+// browserless, e.g. /content, /pdf and /screenshot, which require the token query parameter if set.
+func (c *BrowserlessContainer) URL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// Token returns the token required by the routes, if any.
+func (c *BrowserlessContainer) Token() string {
+	return c.token
+}
+
+// Content returns the HTML content of the page at the given URL, once rendered by Chrome, with the /content
+// REST API. The URL must be reachable from the container, e.g. with the network alias of a container
+// attached to the same network.
+func (c *BrowserlessContainer) Content(ctx context.Context, pageURL string) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string]string{"url": pageURL})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/content"+tokenQuery(c.token), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d for POST /content: %s", resp.StatusCode, respBody)
+	}
+
+	return string(respBody), nil
+}
+
+func (c *BrowserlessContainer) address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
@@ -0,0 +1,142 @@
+package browserless_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/testcontainers/testcontainers-go/modules/browserless"
+)
+
+func TestBrowserless(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := browserless.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		// webSocketURL {
+		webSocketURL, err := container.WebSocketURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		product := browserVersion(t, webSocketURL)
+		if !strings.HasPrefix(product, "HeadlessChrome/") {
+			t.Fatalf("expected headless Chrome, got %s", product)
+		}
+	})
+
+	t.Run("playwright", func(t *testing.T) {
+		// playwrightURL {
+		playwrightURL, err := container.PlaywrightURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the handshake succeeds, the Playwright protocol being spoken by the Playwright clients
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, playwrightURL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	})
+
+	t.Run("content", func(t *testing.T) {
+		// content {
+		content, err := container.Content(ctx, "data:text/html,<h1>Hello world</h1>")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(content, "<h1>Hello world</h1>") {
+			t.Fatalf("expected the rendered page, got %s", content)
+		}
+	})
+}
+
+func TestBrowserlessWithToken(t *testing.T) {
+	ctx := context.Background()
+
+	// withToken {
+	container, err := browserless.RunContainer(ctx, browserless.WithToken("s3cr3t"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	webSocketURL, err := container.WebSocketURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(webSocketURL, "?token=s3cr3t") {
+		t.Fatalf("expected the token in the URL, got %s", webSocketURL)
+	}
+
+	browserVersion(t, webSocketURL)
+
+	baseURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(baseURL + "/pressure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected status code %d without the token, got %d", http.StatusForbidden, resp.StatusCode)
+	}
+}
+
+// browserVersion returns the product of the browser started by the WebSocket endpoint, with the
+// Browser.getVersion method of the Chrome DevTools Protocol.
+func browserVersion(t *testing.T, webSocketURL string) string {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(webSocketURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(map[string]interface{}{"id": 1, "method": "Browser.getVersion"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var result struct {
+		ID     int `json:"id"`
+		Result struct {
+			Product string `json:"product"`
+		} `json:"result"`
+	}
+	if err := conn.ReadJSON(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	return result.Result.Product
+}
@@ -0,0 +1,37 @@
+package browserless_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/browserless"
+)
+
+func ExampleRunContainer() {
+	// runBrowserlessContainer {
+	ctx := context.Background()
+
+	browserlessContainer, err := browserless.RunContainer(ctx, testcontainers.WithImage("browserless/chrome:1.61.1-puppeteer-21.4.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := browserlessContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := browserlessContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,33 @@
+package browserless
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Token is the token required by the routes of browserless.
+	Token string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Browserless container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithToken requires the given token on all the routes of browserless, as the token query parameter,
+// which is added to the URLs returned by the container.
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.Token = token
+	}
+}
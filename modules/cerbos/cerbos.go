@@ -0,0 +1,114 @@
+package cerbos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "3592/tcp"
+	grpcPort = "3593/tcp"
+
+	policyDir = "/policies"
+)
+
+// CerbosContainer represents the Cerbos container type used in the module
+type CerbosContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Cerbos container type, running the policy decision point with
+// the disk storage, which watches the policy repository for changes. Without the WithPolicyDir option,
+// the policy repository is empty and every action is denied.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*CerbosContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "ghcr.io/cerbos/cerbos:0.33.0",
+		ExposedPorts: []string{httpPort, grpcPort},
+		Cmd: []string{
+			"server",
+			"--set=server.httpListenAddr=:3592",
+			"--set=server.grpcListenAddr=:3593",
+			"--set=storage.driver=disk",
+			"--set=storage.disk.directory=" + policyDir,
+			"--set=storage.disk.watchForChanges=true",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/_cerbos/health").WithPort(httpPort),
+			wait.ForListeningPort(grpcPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.PolicyDir != "" {
+		hostDir, err := filepath.Abs(settings.PolicyDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the policy directory: %w", err)
+		}
+
+		hostConfigModifier := genericContainerReq.HostConfigModifier
+		genericContainerReq.HostConfigModifier = func(hostConfig *container.HostConfig) {
+			if hostConfigModifier != nil {
+				hostConfigModifier(hostConfig)
+			}
+			hostConfig.Binds = append(hostConfig.Binds, hostDir+":"+policyDir+":ro")
+		}
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CerbosContainer{Container: container}, nil
+}
+
+// HTTPURL returns the URL of the HTTP API, e.g. "http://localhost:32768", serving the check and plan
+// endpoints under /api, and the playground under /api/playground.
+func (c *CerbosContainer) HTTPURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// GRPCAddress returns the address of the gRPC API, e.g. "localhost:32768", to be used by the Cerbos SDKs
+// without TLS, e.g. with cerbos.New(address, cerbos.WithPlaintext()) of the Go SDK.
+func (c *CerbosContainer) GRPCAddress(ctx context.Context) (string, error) {
+	return c.address(ctx, grpcPort)
+}
+
+func (c *CerbosContainer) address(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
@@ -0,0 +1,162 @@
+package cerbos_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/cerbos"
+)
+
+func TestCerbos(t *testing.T) {
+	ctx := context.Background()
+
+	// withPolicyDir {
+	container, err := cerbos.RunContainer(ctx, cerbos.WithPolicyDir("testdata/policies"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("http", func(t *testing.T) {
+		// httpURL {
+		httpURL, err := container.HTTPURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			principal string
+			role      string
+			expected  map[string]string
+		}{
+			{
+				principal: "alice",
+				role:      "user",
+				expected:  map[string]string{"view": "EFFECT_ALLOW", "edit": "EFFECT_ALLOW", "delete": "EFFECT_DENY"},
+			},
+			{
+				principal: "bob",
+				role:      "user",
+				expected:  map[string]string{"view": "EFFECT_ALLOW", "edit": "EFFECT_DENY", "delete": "EFFECT_DENY"},
+			},
+			{
+				principal: "carol",
+				role:      "admin",
+				expected:  map[string]string{"view": "EFFECT_ALLOW", "edit": "EFFECT_DENY", "delete": "EFFECT_ALLOW"},
+			},
+		}
+
+		for _, test := range tests {
+			actions := checkResource(t, httpURL, test.principal, test.role)
+			for action, effect := range test.expected {
+				if actions[action] != effect {
+					t.Fatalf("expected %s for %s to %s, got %s", effect, test.principal, action, actions[action])
+				}
+			}
+		}
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		// grpcAddress {
+		grpcAddress, err := container.GRPCAddress(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		conn, err := net.Dial("tcp", grpcAddress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	})
+}
+
+func TestCerbosWithoutPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := cerbos.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	httpURL, err := container.HTTPURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := checkResource(t, httpURL, "carol", "admin")
+	if actions["view"] != "EFFECT_DENY" {
+		t.Fatalf("expected the action to be denied, got %s", actions["view"])
+	}
+}
+
+// checkResource checks the actions of the principal, with the given role, on a document owned by alice,
+// returning the effects by action.
+func checkResource(t *testing.T, httpURL string, principal string, role string) map[string]string {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"requestId": "test",
+		"principal": map[string]interface{}{
+			"id":    principal,
+			"roles": []string{role},
+		},
+		"resources": []map[string]interface{}{
+			{
+				"actions": []string{"view", "edit", "delete"},
+				"resource": map[string]interface{}{
+					"kind": "document",
+					"id":   "1",
+					"attr": map[string]string{"owner": "alice"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Post(httpURL+"/api/check/resources", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var result struct {
+		Results []struct {
+			Actions map[string]string `json:"actions"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Results) != 1 {
+		t.Fatalf("expected one result, got %d", len(result.Results))
+	}
+
+	return result.Results[0].Actions
+}
@@ -0,0 +1,37 @@
+package cerbos_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/cerbos"
+)
+
+func ExampleRunContainer() {
+	// runCerbosContainer {
+	ctx := context.Background()
+
+	cerbosContainer, err := cerbos.RunContainer(ctx, testcontainers.WithImage("ghcr.io/cerbos/cerbos:0.33.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := cerbosContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := cerbosContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package cerbos
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// PolicyDir is the host directory mounted as the policy repository.
+	PolicyDir string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Cerbos container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithPolicyDir mounts the given host directory as the policy repository, so the policies of the project,
+// and their schemas in the _schemas folder, are loaded by Cerbos, and the changes to their files are picked up
+// without restarting the container. The directory and its files must be readable by the user of the container.
+func WithPolicyDir(hostDir string) Option {
+	return func(o *options) {
+		o.PolicyDir = hostDir
+	}
+}
@@ -0,0 +1,69 @@
+package chroma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const restPort = "8000/tcp"
+
+// ChromaContainer represents the Chroma container type used in the module
+type ChromaContainer struct {
+	testcontainers.Container
+	authToken string
+}
+
+// RunContainer creates an instance of the Chroma container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*ChromaContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "chromadb/chroma:0.4.22",
+		ExposedPorts: []string{restPort},
+		Env:          map[string]string{},
+		WaitingFor: wait.ForAll(
+			wait.ForListeningPort(restPort),
+			// the heartbeat endpoint does not require authentication
+			wait.ForHTTP("/api/v1/heartbeat").WithPort(restPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChromaContainer{Container: container, authToken: genericContainerReq.Env["CHROMA_SERVER_AUTH_CREDENTIALS"]}, nil
+}
+
+// RESTEndpoint returns the URL of the REST API, e.g. "http://localhost:32768", to be used as
+// the base path of the Chroma clients.
+func (c *ChromaContainer) RESTEndpoint(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// AuthToken returns the token set with the WithAuthToken option, or an empty string if
+// the authentication is not enabled.
+func (c *ChromaContainer) AuthToken() string {
+	return c.authToken
+}
@@ -0,0 +1,93 @@
+package chroma
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestChroma(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("chromadb/chroma:0.4.22"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// restEndpoint {
+	restEndpoint, err := container.RESTEndpoint(ctx)
+	// }
+	require.NoError(t, err)
+
+	status := doRequest(t, http.MethodPost, restEndpoint+"/api/v1/collections", `{"name": "test_collection"}`, "")
+	assert.Equal(t, http.StatusOK, status)
+
+	status = doRequest(t, http.MethodGet, restEndpoint+"/api/v1/collections/test_collection", "", "")
+	assert.Equal(t, http.StatusOK, status)
+
+	assert.Empty(t, container.AuthToken())
+}
+
+func TestChromaWithAuthToken(t *testing.T) {
+	ctx := context.Background()
+
+	// withAuthToken {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("chromadb/chroma:0.4.22"),
+		WithAuthToken("s3cr3t"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	assert.Equal(t, "s3cr3t", container.AuthToken())
+
+	restEndpoint, err := container.RESTEndpoint(ctx)
+	require.NoError(t, err)
+
+	t.Run("heartbeat", func(t *testing.T) {
+		status := doRequest(t, http.MethodGet, restEndpoint+"/api/v1/heartbeat", "", "")
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("without-token", func(t *testing.T) {
+		status := doRequest(t, http.MethodGet, restEndpoint+"/api/v1/collections", "", "")
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("with-token", func(t *testing.T) {
+		status := doRequest(t, http.MethodGet, restEndpoint+"/api/v1/collections", "", container.AuthToken())
+		assert.Equal(t, http.StatusOK, status)
+	})
+}
+
+func doRequest(t *testing.T, method string, url string, body string, token string) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
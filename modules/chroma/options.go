@@ -0,0 +1,17 @@
+package chroma
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// WithAuthToken enables the token based authentication of the REST API, so the clients must send
+// the given token in the "Authorization: Bearer <token>" header. The heartbeat and version
+// endpoints do not require authentication.
+func WithAuthToken(token string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["CHROMA_SERVER_AUTH_PROVIDER"] = "chromadb.auth.token.TokenAuthServerProvider"
+		req.Env["CHROMA_SERVER_AUTH_CREDENTIALS_PROVIDER"] = "chromadb.auth.token.TokenConfigServerAuthCredentialsProvider"
+		req.Env["CHROMA_SERVER_AUTH_TOKEN_TRANSPORT_HEADER"] = "AUTHORIZATION"
+		req.Env["CHROMA_SERVER_AUTH_CREDENTIALS"] = token
+	}
+}
@@ -0,0 +1,68 @@
+package clamav
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const clamdPort = "3310/tcp"
+
+// ClamAVContainer represents the ClamAV container type used in the module
+type ClamAVContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the ClamAV container type, running clamd with the signature
+// databases bundled in the image. The updates of the signatures by freshclam are disabled, so the
+// tests don't depend on the network, and can be enabled by setting the CLAMAV_NO_FRESHCLAMD
+// environment variable to "false". The container is ready once the signatures are loaded and
+// clamd accepts connections, which usually takes less than a minute.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*ClamAVContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "clamav/clamav:1.2.1",
+		ExposedPorts: []string{clamdPort},
+		Env: map[string]string{
+			"CLAMAV_NO_FRESHCLAMD": "true",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("socket found, clamd started."),
+			wait.ForListeningPort(clamdPort),
+		).WithDeadline(3 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClamAVContainer{Container: container}, nil
+}
+
+// Address returns the "host:port" address of clamd, e.g. "localhost:32768", to be used by the clamd
+// clients, e.g. as the "tcp://" address of the TCPAddr setting of clamdscan.
+func (c *ClamAVContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, clamdPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
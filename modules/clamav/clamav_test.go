@@ -0,0 +1,82 @@
+package clamav_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/clamav"
+)
+
+// eicar is the EICAR test file, detected by the antivirus software without being a virus.
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+func TestClamAV(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := clamav.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		if err := container.Ping(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("version", func(t *testing.T) {
+		version, err := container.Version(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasPrefix(version, "ClamAV 1.2.1/") {
+			t.Fatalf("expected the version of ClamAV and of the signatures, got %s", version)
+		}
+	})
+
+	t.Run("scan-clean", func(t *testing.T) {
+		result, err := container.Scan(ctx, strings.NewReader("hello world"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Infected {
+			t.Fatalf("expected a clean stream, got %s", result.Signature)
+		}
+	})
+
+	t.Run("scan-infected", func(t *testing.T) {
+		// scan {
+		result, err := container.Scan(ctx, strings.NewReader(eicar))
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !result.Infected || !strings.Contains(result.Signature, "EICAR") {
+			t.Fatalf("expected the EICAR signature, got %+v", result)
+		}
+	})
+
+	t.Run("address", func(t *testing.T) {
+		// address {
+		address, err := container.Address(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if address == "" {
+			t.Fatal("expected an address")
+		}
+	})
+}
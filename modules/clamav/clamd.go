@@ -0,0 +1,129 @@
+package clamav
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// chunkSize is the size of the chunks of the streams sent to clamd.
+const chunkSize = 32 * 1024
+
+// ScanResult is the result of the scan of a stream by clamd.
+type ScanResult struct {
+	// Infected reports whether a signature matched the stream.
+	Infected bool
+	// Signature is the name of the matching signature, e.g. "Win.Test.EICAR_HDB-1".
+	Signature string
+}
+
+// Ping checks that clamd is available, with the PING command.
+func (c *ClamAVContainer) Ping(ctx context.Context) error {
+	reply, err := c.command(ctx, "PING", nil)
+	if err != nil {
+		return err
+	}
+
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected reply to PING: %s", reply)
+	}
+
+	return nil
+}
+
+// Version returns the version of ClamAV and of its signature databases,
+// e.g. "ClamAV 1.2.1/27160/Tue Jan 16 09:37:14 2024".
+func (c *ClamAVContainer) Version(ctx context.Context) (string, error) {
+	return c.command(ctx, "VERSION", nil)
+}
+
+// Scan scans the given stream with the INSTREAM command, which is limited to the StreamMaxLength
+// setting of clamd, 100MB by default.
+func (c *ClamAVContainer) Scan(ctx context.Context, r io.Reader) (ScanResult, error) {
+	reply, err := c.command(ctx, "INSTREAM", r)
+	if err != nil {
+		return ScanResult{}, err
+	}
+
+	// the reply is "stream: OK" or "stream: <signature> FOUND"
+	result := strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case result == "OK":
+		return ScanResult{}, nil
+	case strings.HasSuffix(result, " FOUND"):
+		return ScanResult{Infected: true, Signature: strings.TrimSuffix(result, " FOUND")}, nil
+	default:
+		return ScanResult{}, fmt.Errorf("failed to scan the stream: %s", reply)
+	}
+}
+
+// command sends the given command to clamd, with the null terminated format, followed by the given stream
+// in chunks, if any, returning the reply.
+func (c *ClamAVContainer) command(ctx context.Context, command string, r io.Reader) (string, error) {
+	address, err := c.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := conn.Write([]byte("z" + command + "\x00")); err != nil {
+		return "", err
+	}
+
+	if r != nil {
+		if err := writeChunks(conn, r); err != nil {
+			return "", err
+		}
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !(errors.Is(err, io.EOF) && reply != "") {
+		return "", fmt.Errorf("failed to read the reply to %s: %w", command, err)
+	}
+
+	return strings.TrimRight(reply, "\x00\n"), nil
+}
+
+// writeChunks writes the stream in chunks prefixed by their length, terminated by an empty chunk.
+func writeChunks(w io.Writer, r io.Reader) error {
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			var chunk bytes.Buffer
+			_ = binary.Write(&chunk, binary.BigEndian, uint32(n))
+			chunk.Write(buf[:n])
+			if _, err := w.Write(chunk.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	return err
+}
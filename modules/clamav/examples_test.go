@@ -0,0 +1,37 @@
+package clamav_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clamav"
+)
+
+func ExampleRunContainer() {
+	// runClamAVContainer {
+	ctx := context.Background()
+
+	clamavContainer, err := clamav.RunContainer(ctx, testcontainers.WithImage("clamav/clamav:1.2.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := clamavContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := clamavContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
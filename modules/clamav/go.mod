@@ -1,11 +1,9 @@
-module github.com/testcontainers/testcontainers-go/examples/nginx
+module github.com/testcontainers/testcontainers-go/modules/clamav
 
 go 1.20
 
 require github.com/testcontainers/testcontainers-go v0.27.0
 
-replace github.com/testcontainers/testcontainers-go => ../..
-
 require (
 	dario.cat/mergo v1.0.0 // indirect
 	github.com/Azure/go-ansiterm v0.0.0-20210617225240-d185dfc1b5a1 // indirect
@@ -56,3 +54,5 @@ require (
 	google.golang.org/grpc v1.58.3 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
 )
+
+replace github.com/testcontainers/testcontainers-go => ../..
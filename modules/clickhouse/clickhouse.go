@@ -1,10 +1,12 @@
 package clickhouse
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/docker/go-connections/nat"
 
@@ -26,6 +28,31 @@ const (
 	// }
 )
 
+const zookeeperConfigPath = "/etc/clickhouse-server/config.d/zookeeper_config.xml"
+
+// zookeeperConfigTpl is the config file used to connect ClickHouse to ZooKeeper, defining
+// the macros used by the replicated tables of a single-shard, single-replica cluster.
+const zookeeperConfigTpl = `<?xml version="1.0"?>
+<clickhouse>
+    <zookeeper>
+        <node>
+            <host>{{.Host}}</host>
+            <port>{{.Port}}</port>
+        </node>
+    </zookeeper>
+    <macros>
+        <shard>01</shard>
+        <replica>replica01</replica>
+    </macros>
+</clickhouse>
+`
+
+// zookeeperOptions is the set of options used to render the ZooKeeper config file
+type zookeeperOptions struct {
+	Host string
+	Port string
+}
+
 // ClickHouseContainer represents the ClickHouse container type used in the module
 type ClickHouseContainer struct {
 	testcontainers.Container
@@ -72,6 +99,65 @@ func (c *ClickHouseContainer) ConnectionString(ctx context.Context, args ...stri
 	return connectionString, nil
 }
 
+// HTTPConnectionString returns the dsn string for the clickhouse container, using the HTTP 8123 port, and
+// obtaining the host and exposed port from the container. It also accepts a variadic list of extra arguments
+// which will be appended to the dsn string, as in the ConnectionString method.
+func (c *ClickHouseContainer) HTTPConnectionString(ctx context.Context, args ...string) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	extraArgs := ""
+	if len(args) > 0 {
+		extraArgs = "?" + strings.Join(args, "&")
+	}
+
+	connectionString := fmt.Sprintf("http://%s:%s@%s:%s/%s%s", c.user, c.password, host, port.Port(), c.dbName, extraArgs)
+	return connectionString, nil
+}
+
+// WithZookeeper connects the ClickHouse server to the ZooKeeper server running at the given host and port,
+// which is required to use replicated tables and distributed DDL queries. The ZooKeeper server must be
+// reachable from the ClickHouse container, e.g. using a network alias in the same Docker network.
+// The {shard} and {replica} macros are defined, so they can be used in the replicated tables' paths.
+func WithZookeeper(host, port string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					config, err := renderZookeeperConfig(zookeeperOptions{Host: host, Port: port})
+					if err != nil {
+						return err
+					}
+
+					return c.CopyToContainer(ctx, config, zookeeperConfigPath, 0o755)
+				},
+			},
+		})
+	}
+}
+
+// renderZookeeperConfig renders the ZooKeeper config file, returning it as byte array.
+func renderZookeeperConfig(settings zookeeperOptions) ([]byte, error) {
+	tpl, err := template.New("zookeeper.xml").Parse(zookeeperConfigTpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse zookeeper config file template: %w", err)
+	}
+
+	var config bytes.Buffer
+	if err := tpl.Execute(&config, settings); err != nil {
+		return nil, fmt.Errorf("failed to render zookeeper config file template: %w", err)
+	}
+
+	return config.Bytes(), nil
+}
+
 // WithInitScripts sets the init scripts to be run when the container starts
 func WithInitScripts(scripts ...string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
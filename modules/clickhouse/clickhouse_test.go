@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
 )
 
 const (
@@ -128,6 +130,105 @@ func TestClickHouseDSN(t *testing.T) {
 	assert.Len(t, data, 1)
 }
 
+func TestClickHouseHTTPDSN(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, WithUsername(user), WithPassword(password), WithDatabase(dbname))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// httpConnectionString {
+	connectionString, err := container.HTTPConnectionString(ctx, "debug=true")
+	// }
+	require.NoError(t, err)
+
+	opts, err := ch.ParseDSN(connectionString)
+	require.NoError(t, err)
+	assert.Equal(t, ch.HTTP, opts.Protocol)
+
+	conn, err := ch.Open(opts)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.Ping(ctx))
+}
+
+func TestClickHouseWithZookeeper(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx, network.WithCheckDuplicate())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	zkContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "zookeeper:3.7",
+			ExposedPorts: []string{"2181/tcp"},
+			WaitingFor:   wait.ForListeningPort("2181/tcp"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, zkContainer.Terminate(ctx))
+	})
+
+	// the zookeeper container must be attached to the network before starting the clickhouse one
+	require.NoError(t, nw.Connect(ctx, zkContainer, "zookeeper"))
+
+	// withZookeeper {
+	container, err := RunContainer(ctx,
+		WithUsername(user),
+		WithPassword(password),
+		WithDatabase(dbname),
+		WithZookeeper("zookeeper", "2181"),
+		network.WithNetwork([]string{"clickhouse"}, nw),
+	)
+	// }
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	connectionString, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	opts, err := ch.ParseDSN(connectionString)
+	require.NoError(t, err)
+
+	conn, err := ch.Open(opts)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// replicated tables need a working connection to zookeeper
+	err = conn.Exec(ctx, `CREATE TABLE replicated_test (id UInt64) ENGINE = ReplicatedMergeTree('/clickhouse/tables/{shard}/replicated_test', '{replica}') ORDER BY id`)
+	require.NoError(t, err)
+
+	err = conn.Exec(ctx, "INSERT INTO replicated_test VALUES (1)")
+	require.NoError(t, err)
+
+	var count uint64
+	err = conn.QueryRow(ctx, "SELECT count() FROM replicated_test").Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+}
+
+func TestRenderZookeeperConfig(t *testing.T) {
+	config, err := renderZookeeperConfig(zookeeperOptions{Host: "zookeeper", Port: "2181"})
+	require.NoError(t, err)
+
+	assert.Contains(t, string(config), "<host>zookeeper</host>")
+	assert.Contains(t, string(config), "<port>2181</port>")
+}
+
 func TestClickHouseWithInitScripts(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,246 @@
+package cockroachdb
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultUser      = "root"
+	defaultDatabase  = "defaultdb"
+	defaultStoreSize = "100%"
+
+	defaultSQLPort  = "26257/tcp"
+	defaultHTTPPort = "8080/tcp"
+
+	cockroachDir   = "/cockroach"
+	certsDir       = cockroachDir + "/certs"
+	initScriptsDir = "/docker-entrypoint-initdb.d"
+)
+
+// CockroachDBContainer represents the CockroachDB container type used in the module
+type CockroachDBContainer struct {
+	testcontainers.Container
+	opts options
+	// certsDir is the directory in the host where the generated certificates are stored,
+	// so that they can be referenced from the connection string. It's empty in insecure mode.
+	certsDir  string
+	tlsConfig *tls.Config
+}
+
+// RunContainer creates an instance of the CockroachDB container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*CockroachDBContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "cockroachdb/cockroach:latest-v23.1",
+		ExposedPorts: []string{defaultSQLPort, defaultHTTPPort},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.Password != "" && !settings.TLS {
+		return nil, errors.New("a password can only be set in secure mode, please use the WithTLS option")
+	}
+
+	c := &CockroachDBContainer{opts: settings}
+
+	if err := configure(&genericContainerReq, c); err != nil {
+		return nil, err
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		if c.certsDir != "" {
+			_ = os.RemoveAll(c.certsDir)
+		}
+		return nil, err
+	}
+
+	c.Container = container
+
+	return c, nil
+}
+
+// configure sets the command, the environment and the wait strategy of the container,
+// generating the certificates when the node is started in secure mode.
+func configure(req *testcontainers.GenericContainerRequest, c *CockroachDBContainer) error {
+	settings := c.opts
+
+	if req.Env == nil {
+		req.Env = map[string]string{}
+	}
+	req.Env["COCKROACH_DATABASE"] = settings.Database
+	req.Env["COCKROACH_USER"] = settings.User
+	if settings.Password != "" {
+		req.Env["COCKROACH_PASSWORD"] = settings.Password
+	}
+
+	req.Cmd = []string{"start-single-node", "--store=type=mem,size=" + settings.StoreSize}
+
+	// the entrypoint creates this file once the database, the user and the init scripts are processed
+	initialized := wait.ForExec([]string{"test", "-f", cockroachDir + "/init_success"})
+
+	if !settings.TLS {
+		req.Cmd = append(req.Cmd, "--insecure")
+		req.WaitingFor = wait.ForAll(
+			wait.ForHTTP("/health").WithPort(defaultHTTPPort),
+			initialized,
+		)
+		return nil
+	}
+
+	req.Cmd = append(req.Cmd, "--certs-dir="+certsDir)
+	req.Env["COCKROACH_CERTS_DIR"] = certsDir
+
+	// the entrypoint uses the root user to create the database and the user,
+	// so a client certificate for root is always needed.
+	users := []string{defaultUser}
+	if settings.User != defaultUser {
+		users = append(users, settings.User)
+	}
+
+	certs, err := generateCertificates([]string{"localhost", "127.0.0.1", "::1", "node"}, users...)
+	if err != nil {
+		return fmt.Errorf("failed to generate certificates: %w", err)
+	}
+
+	files := map[string][]byte{
+		"ca.crt":   certs.CACert,
+		"node.crt": certs.NodeCert,
+		"node.key": certs.NodeKey,
+	}
+	for _, user := range users {
+		files["client."+user+".crt"] = certs.ClientCerts[user]
+		files["client."+user+".key"] = certs.ClientKeys[user]
+	}
+
+	c.certsDir, err = os.MkdirTemp("", "testcontainers-cockroachdb-")
+	if err != nil {
+		return fmt.Errorf("failed to create the certificates directory: %w", err)
+	}
+
+	hostFiles := []string{"ca.crt", "client." + settings.User + ".crt", "client." + settings.User + ".key"}
+	for _, name := range hostFiles {
+		if err := os.WriteFile(filepath.Join(c.certsDir, name), files[name], 0o600); err != nil {
+			_ = os.RemoveAll(c.certsDir)
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	c.tlsConfig, err = newTLSConfig(certs.CACert, certs.ClientCerts[settings.User], certs.ClientKeys[settings.User])
+	if err != nil {
+		_ = os.RemoveAll(c.certsDir)
+		return err
+	}
+
+	hostCertsDir := c.certsDir
+	req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostCreates: []testcontainers.ContainerHook{
+			func(ctx context.Context, container testcontainers.Container) error {
+				for name, content := range files {
+					// CockroachDB refuses to use keys readable by others
+					if err := container.CopyToContainer(ctx, content, certsDir+"/"+name, 0o600); err != nil {
+						return fmt.Errorf("failed to copy %s: %w", name, err)
+					}
+				}
+				return nil
+			},
+		},
+		PostTerminates: []testcontainers.ContainerHook{
+			func(ctx context.Context, container testcontainers.Container) error {
+				return os.RemoveAll(hostCertsDir)
+			},
+		},
+	})
+
+	req.WaitingFor = wait.ForAll(
+		wait.ForHTTP("/health").WithPort(defaultHTTPPort).WithTLS(true, &tls.Config{RootCAs: c.tlsConfig.RootCAs, ServerName: "localhost"}),
+		initialized,
+	)
+
+	return nil
+}
+
+// newTLSConfig returns a TLS config trusting the given CA, which presents the given client certificate.
+func newTLSConfig(caCert, clientCert, clientKey []byte) (*tls.Config, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to add the CA certificate to the pool")
+	}
+
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+	}
+
+	return &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		ServerName:   "localhost",
+	}, nil
+}
+
+// ConnectionString returns a PostgreSQL connection string for the database and user of the container,
+// which can be used with pgx or any other PostgreSQL driver.
+// In secure mode, it verifies the server certificate against the generated CA, and includes the paths
+// to the generated client certificate and key of the user.
+func (c *CockroachDBContainer) ConnectionString(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, defaultSQLPort)
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.User(c.opts.User),
+		Host:   net.JoinHostPort(host, port.Port()),
+		Path:   c.opts.Database,
+	}
+	if c.opts.Password != "" {
+		u.User = url.UserPassword(c.opts.User, c.opts.Password)
+	}
+
+	params := url.Values{}
+	if c.certsDir == "" {
+		params.Set("sslmode", "disable")
+	} else {
+		params.Set("sslmode", "verify-full")
+		params.Set("sslrootcert", filepath.Join(c.certsDir, "ca.crt"))
+		params.Set("sslcert", filepath.Join(c.certsDir, "client."+c.opts.User+".crt"))
+		params.Set("sslkey", filepath.Join(c.certsDir, "client."+c.opts.User+".key"))
+	}
+	u.RawQuery = params.Encode()
+
+	return u.String(), nil
+}
+
+// TLSConfig returns a TLS config trusting the generated CA, which presents the client certificate
+// of the user. It returns nil if the container was not started in secure mode.
+func (c *CockroachDBContainer) TLSConfig() *tls.Config {
+	return c.tlsConfig
+}
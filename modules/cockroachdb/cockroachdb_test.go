@@ -0,0 +1,118 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestCockroachDB(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("cockroachdb/cockroach:latest-v23.1"),
+		WithDatabase("testdb"),
+		WithUser("tc"),
+	)
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx)
+	// }
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(connStr, "postgres://tc@"))
+	assert.Contains(t, connStr, "/testdb?sslmode=disable")
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var current string
+	err = db.QueryRowContext(ctx, "SELECT current_user").Scan(&current)
+	require.NoError(t, err)
+	assert.Equal(t, "tc", current)
+
+	assert.Nil(t, container.TLSConfig())
+}
+
+func TestCockroachDBWithTLS(t *testing.T) {
+	ctx := context.Background()
+
+	// withTLS {
+	container, err := RunContainer(ctx,
+		WithDatabase("testdb"),
+		WithUser("tc"),
+		WithPassword("s3cr3t"),
+		WithTLS(),
+	)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(connStr, "postgres://tc:s3cr3t@"))
+	assert.Contains(t, connStr, "sslmode=verify-full")
+	assert.Contains(t, connStr, "sslrootcert=")
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.PingContext(ctx))
+	require.NotNil(t, container.TLSConfig())
+}
+
+func TestCockroachDBWithInitScripts(t *testing.T) {
+	ctx := context.Background()
+
+	// withInitScripts {
+	container, err := RunContainer(ctx,
+		WithInitScripts(filepath.Join("testdata", "__init.sql")),
+	)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var name string
+	err = db.QueryRowContext(ctx, "SELECT name FROM test WHERE id = 1").Scan(&name)
+	require.NoError(t, err)
+	assert.Equal(t, "testcontainers", name)
+}
+
+func TestCockroachDBPasswordRequiresTLS(t *testing.T) {
+	_, err := RunContainer(context.Background(), WithPassword("s3cr3t"))
+	require.Error(t, err)
+}
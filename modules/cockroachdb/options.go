@@ -0,0 +1,96 @@
+package cockroachdb
+
+import (
+	"path/filepath"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Database is the name of the database created when the container starts.
+	Database string
+	// User is the name of the user created when the container starts.
+	User string
+	// Password is the password of the user. It can only be used in secure mode.
+	Password string
+	// StoreSize is the size of the in-memory store of the node, e.g. "50%" or "1GiB".
+	StoreSize string
+	// TLS enables the secure mode of the node, using certificates generated when the container starts.
+	TLS bool
+}
+
+func defaultOptions() options {
+	return options{
+		Database:  defaultDatabase,
+		User:      defaultUser,
+		StoreSize: defaultStoreSize,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the CockroachDB container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithDatabase sets the name of the database to be created when the container starts.
+// If it is not specified, then "defaultdb" will be used.
+func WithDatabase(database string) Option {
+	return func(o *options) {
+		o.Database = database
+	}
+}
+
+// WithUser sets the name of the user to be created when the container starts.
+// If it is not specified, then the "root" user will be used.
+func WithUser(user string) Option {
+	return func(o *options) {
+		o.User = user
+	}
+}
+
+// WithPassword sets the password of the user created when the container starts.
+// CockroachDB only supports password authentication in secure mode, so it requires the WithTLS option.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.Password = password
+	}
+}
+
+// WithStoreSize sets the size of the in-memory store of the node, either as a percentage of the
+// available memory, e.g. "50%", or as an absolute size, e.g. "1GiB". It defaults to "100%".
+func WithStoreSize(size string) Option {
+	return func(o *options) {
+		o.StoreSize = size
+	}
+}
+
+// WithTLS starts the node in secure mode, using a CA, and a node and a client certificate for the
+// root user signed by it, which are generated when the container starts. The connection string
+// returned by the container includes the paths to the client certificates.
+func WithTLS() Option {
+	return func(o *options) {
+		o.TLS = true
+	}
+}
+
+// WithInitScripts sets the init scripts to be run when the container starts, in alphabetical order.
+// Only *.sql and *.sh scripts are supported, and they are run after the database and user are created.
+func WithInitScripts(scripts ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		initScripts := make([]testcontainers.ContainerFile, 0, len(scripts))
+		for _, script := range scripts {
+			initScripts = append(initScripts, testcontainers.ContainerFile{
+				HostFilePath:      script,
+				ContainerFilePath: initScriptsDir + "/" + filepath.Base(script),
+				FileMode:          0o755,
+			})
+		}
+		req.Files = append(req.Files, initScripts...)
+	}
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS test (
+  id INT PRIMARY KEY,
+  name STRING NOT NULL
+);
+
+INSERT INTO test (id, name) VALUES (1, 'testcontainers');
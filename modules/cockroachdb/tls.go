@@ -0,0 +1,51 @@
+package cockroachdb
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go/internal/tlscert"
+)
+
+// certificates holds the PEM encoded certificates and keys used to start the node in secure mode.
+type certificates struct {
+	CACert []byte
+	// NodeCert and NodeKey are used by the node to serve the SQL and HTTP connections.
+	NodeCert []byte
+	NodeKey  []byte
+	// ClientCerts and ClientKeys are indexed by the name of the user.
+	ClientCerts map[string][]byte
+	ClientKeys  map[string][]byte
+}
+
+// generateCertificates creates a self-signed CA, a node certificate valid for the given hosts
+// and a client certificate for each one of the given users, all of them signed by that CA.
+func generateCertificates(hosts []string, users ...string) (*certificates, error) {
+	ca, err := tlscert.NewCA("Testcontainers CockroachDB CA")
+	if err != nil {
+		return nil, err
+	}
+
+	certs := &certificates{
+		CACert:      ca.Cert,
+		ClientCerts: map[string][]byte{},
+		ClientKeys:  map[string][]byte{},
+	}
+
+	// the node certificate is also used by the node to connect to other nodes, acting as a client,
+	// so it must be valid for both server and client authentication.
+	certs.NodeCert, certs.NodeKey, err = ca.Sign("node", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create node certificate: %w", err)
+	}
+
+	for _, user := range users {
+		// CockroachDB authenticates the client using the common name of the certificate
+		certs.ClientCerts[user], certs.ClientKeys[user], err = ca.Sign(user, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client certificate for %s: %w", user, err)
+		}
+	}
+
+	return certs, nil
+}
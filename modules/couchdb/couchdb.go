@@ -0,0 +1,146 @@
+package couchdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "5984/tcp"
+
+const (
+	// DefaultAdminUser is the default user of the admin account.
+	DefaultAdminUser = "admin"
+	// DefaultAdminPassword is the default password of the admin account.
+	DefaultAdminPassword = "password"
+)
+
+// CouchDBContainer represents the CouchDB container type used in the module
+type CouchDBContainer struct {
+	testcontainers.Container
+	adminUser     string
+	adminPassword string
+}
+
+// RunContainer creates an instance of the CouchDB container type, with an admin account. The single node
+// cluster setup is finished when the container starts, creating the _users, _replicator and _global_changes
+// system databases, so the container is ready to use like a production node.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*CouchDBContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "couchdb:3.3.3",
+		ExposedPorts: []string{httpPort},
+		WaitingFor:   wait.ForHTTP("/_up").WithPort(httpPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env == nil {
+		genericContainerReq.Env = map[string]string{}
+	}
+	genericContainerReq.Env["COUCHDB_USER"] = settings.AdminUser
+	genericContainerReq.Env["COUCHDB_PASSWORD"] = settings.AdminPassword
+
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				couchdb := &CouchDBContainer{Container: c, adminUser: settings.AdminUser, adminPassword: settings.AdminPassword}
+				return couchdb.enableSingleNode(ctx)
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CouchDBContainer{
+		Container:     container,
+		adminUser:     settings.AdminUser,
+		adminPassword: settings.AdminPassword,
+	}, nil
+}
+
+// enableSingleNode finishes the cluster setup of a single node, with the _cluster_setup endpoint.
+func (c *CouchDBContainer) enableSingleNode(ctx context.Context) error {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"action":       "enable_single_node",
+		"username":     c.adminUser,
+		"password":     c.adminPassword,
+		"bind_address": "0.0.0.0",
+		"port":         5984,
+		"singlenode":   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/_cluster_setup", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.adminUser, c.adminPassword)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to finish the single node cluster setup, status code %d: %s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
+
+// AdminUser returns the user of the admin account.
+func (c *CouchDBContainer) AdminUser() string {
+	return c.adminUser
+}
+
+// AdminPassword returns the password of the admin account.
+func (c *CouchDBContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// URL returns the base URL of the CouchDB HTTP API, e.g. "http://localhost:32768", to be used by the
+// CouchDB clients, authenticated with the admin account.
+func (c *CouchDBContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
@@ -0,0 +1,84 @@
+package couchdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/couchdb"
+)
+
+func TestCouchDB(t *testing.T) {
+	ctx := context.Background()
+
+	// withAdminCredentials {
+	container, err := couchdb.RunContainer(ctx, couchdb.WithAdminCredentials("root", "s3cr3t"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	url, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the system databases are created by the single node cluster setup
+	var state struct {
+		State string `json:"state"`
+	}
+	request(t, container, http.MethodGet, url+"/_cluster_setup", "", http.StatusOK, &state)
+	if state.State != "single_node_enabled" {
+		t.Fatalf("expected the single node cluster setup to be finished, got %s", state.State)
+	}
+	request(t, container, http.MethodGet, url+"/_users", "", http.StatusOK, nil)
+
+	request(t, container, http.MethodPut, url+"/orders", "", http.StatusCreated, nil)
+	request(t, container, http.MethodPut, url+"/orders/order-1", `{"amount": 42}`, http.StatusCreated, nil)
+
+	var order struct {
+		Amount int `json:"amount"`
+	}
+	request(t, container, http.MethodGet, url+"/orders/order-1", "", http.StatusOK, &order)
+	if order.Amount != 42 {
+		t.Fatalf("expected the amount to be 42, got %d", order.Amount)
+	}
+}
+
+func request(t *testing.T, container *couchdb.CouchDBContainer, method string, url string, body string, expectedStatus int, v interface{}) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(container.AdminUser(), container.AdminPassword())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		t.Fatalf("expected status code %d for %s %s, got %d", expectedStatus, method, url, resp.StatusCode)
+	}
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
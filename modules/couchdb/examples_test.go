@@ -0,0 +1,37 @@
+package couchdb_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/couchdb"
+)
+
+func ExampleRunContainer() {
+	// runCouchDBContainer {
+	ctx := context.Background()
+
+	couchdbContainer, err := couchdb.RunContainer(ctx, testcontainers.WithImage("couchdb:3.3.3"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := couchdbContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := couchdbContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,70 @@
+package debezium
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/kafkaconnect"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const restPort = "8083/tcp"
+
+// DebeziumContainer represents the Debezium container type used in the module.
+// It's a Kafka Connect worker with the Debezium connectors installed, so the methods of
+// the Kafka Connect module are available to manage the connectors.
+type DebeziumContainer struct {
+	*kafkaconnect.KafkaConnectContainer
+}
+
+// RunContainer creates an instance of the Debezium container type, running a Kafka Connect worker
+// with the Debezium connectors. The worker must join the network of a Kafka or Redpanda cluster,
+// using network.WithNetwork, and the bootstrap servers of the cluster must be set with
+// the WithBootstrapServers option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DebeziumContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "quay.io/debezium/connect:2.5.0.Final",
+		ExposedPorts: []string{restPort},
+		Env: map[string]string{
+			"GROUP_ID":                          "testcontainers-debezium",
+			"CONFIG_STORAGE_TOPIC":              "_debezium-configs",
+			"OFFSET_STORAGE_TOPIC":              "_debezium-offsets",
+			"STATUS_STORAGE_TOPIC":              "_debezium-status",
+			"CONFIG_STORAGE_REPLICATION_FACTOR": "1",
+			"OFFSET_STORAGE_REPLICATION_FACTOR": "1",
+			"STATUS_STORAGE_REPLICATION_FACTOR": "1",
+		},
+		WaitingFor: wait.ForHTTP("/connectors").WithPort(restPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env["BOOTSTRAP_SERVERS"] == "" {
+		return nil, errors.New("the bootstrap servers are required, use the WithBootstrapServers option")
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DebeziumContainer{KafkaConnectContainer: &kafkaconnect.KafkaConnectContainer{Container: container}}, nil
+}
+
+// WithBootstrapServers sets the bootstrap servers of the Kafka cluster, e.g. "redpanda:29092",
+// which must be reachable from the network of the container.
+func WithBootstrapServers(servers ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["BOOTSTRAP_SERVERS"] = strings.Join(servers, ",")
+	}
+}
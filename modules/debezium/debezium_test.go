@@ -0,0 +1,99 @@
+package debezium_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/modules/debezium"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+func TestPostgresCDC(t *testing.T) {
+	ctx := context.Background()
+
+	// runPostgresCDC {
+	harness, err := debezium.RunPostgresCDC(ctx,
+		debezium.WithPostgresOptions(postgres.WithInitScripts(filepath.Join("testdata", "init.sql"))),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the containers and the network after the test is complete
+	t.Cleanup(func() {
+		if err := harness.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate the harness: %s", err)
+		}
+	})
+
+	// registerConnector {
+	err = harness.RegisterConnector(ctx, "users-connector", debezium.PostgresConnectorConfig{
+		TopicPrefix: "cdc",
+		Tables:      []string{"public.users"},
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, _, err := harness.Postgres.Exec(ctx, []string{
+		"psql", "-U", "postgres", "-c", "INSERT INTO users (name) VALUES ('bob'); DELETE FROM users WHERE name = 'alice';",
+	}, exec.Multiplexed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	// changeEvents {
+	events, err := harness.ChangeEvents(timeoutCtx, debezium.Topic("cdc", "public.users"), 3)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		op   string
+		name string
+	}{
+		{op: "r", name: "alice"},
+		{op: "c", name: "bob"},
+		{op: "d"},
+	}
+
+	for i, e := range expected {
+		event := events[i]
+		if event.Op != e.op {
+			t.Fatalf("expected operation %s for event %d, got %s", e.op, i, event.Op)
+		}
+
+		if event.Source.Table != "users" {
+			t.Fatalf("expected table users for event %d, got %s", i, event.Source.Table)
+		}
+
+		if e.op == "d" {
+			if event.After != nil || event.Before["id"] != float64(1) {
+				t.Fatalf("unexpected delete event: %+v", event)
+			}
+			continue
+		}
+
+		if event.After["name"] != e.name {
+			t.Fatalf("expected name %s for event %d, got %v", e.name, i, event.After["name"])
+		}
+	}
+}
+
+func TestTopic(t *testing.T) {
+	if topic := debezium.Topic("cdc", "public.users"); topic != "cdc.public.users" {
+		t.Fatalf("expected cdc.public.users, got %s", topic)
+	}
+}
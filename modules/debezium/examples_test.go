@@ -0,0 +1,69 @@
+package debezium_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/debezium"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func ExampleRunContainer() {
+	// runDebeziumContainer {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := nw.Remove(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	redpandaContainer, err := redpanda.RunContainer(ctx,
+		network.WithNetwork([]string{"redpanda"}, nw),
+		redpanda.WithListener("redpanda:29092"),
+		redpanda.WithAutoCreateTopics(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := redpandaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	debeziumContainer, err := debezium.RunContainer(ctx,
+		testcontainers.WithImage("quay.io/debezium/connect:2.5.0.Final"),
+		network.WithNetwork([]string{"debezium"}, nw),
+		debezium.WithBootstrapServers("redpanda:29092"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := debeziumContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := debeziumContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
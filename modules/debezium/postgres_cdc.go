@@ -0,0 +1,339 @@
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	kafkaAlias    = "redpanda"
+	kafkaListener = kafkaAlias + ":29092"
+	postgresAlias = "postgres"
+	connectAlias  = "debezium"
+
+	postgresConnectorClass = "io.debezium.connector.postgresql.PostgresConnector"
+)
+
+// PostgresCDC is a harness for change data capture tests, wiring a Postgres container,
+// with the logical replication enabled, a Redpanda container and a Debezium container
+// on the same network.
+type PostgresCDC struct {
+	Network  *testcontainers.DockerNetwork
+	Kafka    *redpanda.Container
+	Postgres *postgres.PostgresContainer
+	Connect  *DebeziumContainer
+
+	// the credentials of the Postgres container, used by the connectors
+	postgresUser     string
+	postgresPassword string
+	postgresDB       string
+}
+
+type postgresCDCOptions struct {
+	PostgresOpts []testcontainers.ContainerCustomizer
+	KafkaOpts    []testcontainers.ContainerCustomizer
+	ConnectOpts  []testcontainers.ContainerCustomizer
+}
+
+// PostgresCDCOption is an option for the PostgresCDC harness.
+type PostgresCDCOption func(*postgresCDCOptions)
+
+// WithPostgresOptions adds options to the Postgres container, e.g. postgres.WithInitScripts
+// to create the tables to capture, or testcontainers.WithImage to use a different version.
+func WithPostgresOptions(opts ...testcontainers.ContainerCustomizer) PostgresCDCOption {
+	return func(o *postgresCDCOptions) {
+		o.PostgresOpts = append(o.PostgresOpts, opts...)
+	}
+}
+
+// WithKafkaOptions adds options to the Redpanda container.
+func WithKafkaOptions(opts ...testcontainers.ContainerCustomizer) PostgresCDCOption {
+	return func(o *postgresCDCOptions) {
+		o.KafkaOpts = append(o.KafkaOpts, opts...)
+	}
+}
+
+// WithConnectOptions adds options to the Debezium container.
+func WithConnectOptions(opts ...testcontainers.ContainerCustomizer) PostgresCDCOption {
+	return func(o *postgresCDCOptions) {
+		o.ConnectOpts = append(o.ConnectOpts, opts...)
+	}
+}
+
+// RunPostgresCDC creates a network, and starts the Redpanda, Postgres and Debezium containers on it.
+// If any container fails to start, the ones already started and the network are removed.
+func RunPostgresCDC(ctx context.Context, opts ...PostgresCDCOption) (*PostgresCDC, error) {
+	var settings postgresCDCOptions
+	for _, opt := range opts {
+		opt(&settings)
+	}
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	h := &PostgresCDC{Network: nw}
+
+	kafkaOpts := append([]testcontainers.ContainerCustomizer{
+		network.WithNetwork([]string{kafkaAlias}, nw),
+		redpanda.WithListener(kafkaListener),
+		redpanda.WithAutoCreateTopics(),
+	}, settings.KafkaOpts...)
+
+	h.Kafka, err = redpanda.RunContainer(ctx, kafkaOpts...)
+	if err != nil {
+		_ = h.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start redpanda: %w", err)
+	}
+
+	postgresOpts := append([]testcontainers.ContainerCustomizer{
+		network.WithNetwork([]string{postgresAlias}, nw),
+		withLogicalReplication(),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(time.Minute),
+		),
+	}, settings.PostgresOpts...)
+	// capture the credentials once all the options are applied
+	postgresOpts = append(postgresOpts, testcontainers.CustomizeRequestOption(func(req *testcontainers.GenericContainerRequest) {
+		h.postgresUser = req.Env["POSTGRES_USER"]
+		h.postgresPassword = req.Env["POSTGRES_PASSWORD"]
+		h.postgresDB = req.Env["POSTGRES_DB"]
+	}))
+
+	h.Postgres, err = postgres.RunContainer(ctx, postgresOpts...)
+	if err != nil {
+		_ = h.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start postgres: %w", err)
+	}
+
+	connectOpts := append([]testcontainers.ContainerCustomizer{
+		network.WithNetwork([]string{connectAlias}, nw),
+		WithBootstrapServers(kafkaListener),
+	}, settings.ConnectOpts...)
+
+	h.Connect, err = RunContainer(ctx, connectOpts...)
+	if err != nil {
+		_ = h.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start debezium: %w", err)
+	}
+
+	return h, nil
+}
+
+// withLogicalReplication starts Postgres with the logical WAL level, required by Debezium.
+func withLogicalReplication() testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Cmd = append(req.Cmd, "-c", "wal_level=logical")
+	}
+}
+
+// Terminate terminates the Debezium, Postgres and Redpanda containers, and removes the network.
+func (h *PostgresCDC) Terminate(ctx context.Context) error {
+	var errs []error
+
+	if h.Connect != nil {
+		errs = append(errs, h.Connect.Terminate(ctx))
+	}
+
+	if h.Postgres != nil {
+		errs = append(errs, h.Postgres.Terminate(ctx))
+	}
+
+	if h.Kafka != nil {
+		errs = append(errs, h.Kafka.Terminate(ctx))
+	}
+
+	if h.Network != nil {
+		errs = append(errs, h.Network.Remove(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// PostgresConnectorConfig is the configuration of a Debezium Postgres connector,
+// capturing the changes of the Postgres container of the harness.
+type PostgresConnectorConfig struct {
+	// TopicPrefix is the prefix of the topics of the change events, which are named "<prefix>.<schema>.<table>".
+	TopicPrefix string
+	// Tables is the list of tables to capture, as "<schema>.<table>". All the tables are captured if empty.
+	Tables []string
+	// SlotName is the name of the replication slot, which must be unique per connector. Defaults to "debezium".
+	SlotName string
+	// Properties are additional properties of the connector, which override the ones set by the harness.
+	Properties map[string]string
+}
+
+// RegisterConnector registers a Debezium Postgres connector with the given name, and waits
+// for the connector and its tasks to be running. The change events are serialised as JSON,
+// without schemas, and the deletes are not followed by tombstones.
+func (h *PostgresCDC) RegisterConnector(ctx context.Context, name string, cfg PostgresConnectorConfig) error {
+	if cfg.TopicPrefix == "" {
+		return errors.New("the topic prefix is required")
+	}
+
+	config := map[string]string{
+		"connector.class":                postgresConnectorClass,
+		"database.hostname":              postgresAlias,
+		"database.port":                  "5432",
+		"database.user":                  h.postgresUser,
+		"database.password":              h.postgresPassword,
+		"database.dbname":                h.postgresDB,
+		"topic.prefix":                   cfg.TopicPrefix,
+		"plugin.name":                    "pgoutput",
+		"tombstones.on.delete":           "false",
+		"key.converter":                  "org.apache.kafka.connect.json.JsonConverter",
+		"key.converter.schemas.enable":   "false",
+		"value.converter":                "org.apache.kafka.connect.json.JsonConverter",
+		"value.converter.schemas.enable": "false",
+	}
+
+	if len(cfg.Tables) > 0 {
+		config["table.include.list"] = strings.Join(cfg.Tables, ",")
+	}
+
+	if cfg.SlotName != "" {
+		config["slot.name"] = cfg.SlotName
+	}
+
+	for k, v := range cfg.Properties {
+		config[k] = v
+	}
+
+	if err := h.Connect.CreateConnector(ctx, name, config); err != nil {
+		return fmt.Errorf("failed to create connector: %w", err)
+	}
+
+	return h.waitForConnector(ctx, name)
+}
+
+// waitForConnector polls the status of the connector until the connector and all its tasks are running,
+// failing if any of them failed.
+func (h *PostgresCDC) waitForConnector(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	for {
+		status, err := h.Connect.ConnectorStatus(ctx, name)
+		if err == nil {
+			if status.Connector.State == "FAILED" {
+				return fmt.Errorf("connector %s failed: %s", name, status.Connector.Trace)
+			}
+
+			running := status.Connector.State == "RUNNING" && len(status.Tasks) > 0
+			for _, task := range status.Tasks {
+				if task.State == "FAILED" {
+					return fmt.Errorf("task %d of connector %s failed: %s", task.ID, name, task.Trace)
+				}
+				running = running && task.State == "RUNNING"
+			}
+
+			if running {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("connector %s is not running: %w", name, ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// ChangeEvent is a change event produced by a Debezium connector.
+type ChangeEvent struct {
+	// Before is the state of the row before the change, nil for creates and snapshot reads.
+	// It only contains the primary key for updates and deletes, unless the REPLICA IDENTITY of the table is FULL.
+	Before map[string]interface{} `json:"before"`
+	// After is the state of the row after the change, nil for deletes.
+	After map[string]interface{} `json:"after"`
+	// Op is the operation: "c" for create, "u" for update, "d" for delete and "r" for snapshot read.
+	Op     string            `json:"op"`
+	Source ChangeEventSource `json:"source"`
+	// TsMs is the time, in milliseconds since the epoch, at which the connector processed the change.
+	TsMs int64 `json:"ts_ms"`
+}
+
+// ChangeEventSource is the metadata of the source of a change event.
+type ChangeEventSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	DB        string `json:"db"`
+	Schema    string `json:"schema"`
+	Table     string `json:"table"`
+	Snapshot  string `json:"snapshot"`
+	LSN       int64  `json:"lsn"`
+	TxID      int64  `json:"txId"`
+}
+
+// Topic returns the topic of the change events of the given table, e.g. "public.users",
+// captured by a connector with the given topic prefix.
+func Topic(prefix string, table string) string {
+	return prefix + "." + table
+}
+
+// ChangeEvents consumes the given topic from the beginning, until n change events are received,
+// returning them in order. It returns an error if the context is done before.
+func (h *PostgresCDC) ChangeEvents(ctx context.Context, topic string, n int) ([]ChangeEvent, error) {
+	broker, err := h.Kafka.KafkaSeedBroker(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := kgo.NewClient(
+		kgo.SeedBrokers(broker),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+	defer cl.Close()
+
+	events := make([]ChangeEvent, 0, n)
+	for len(events) < n {
+		fetches := cl.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			return events, fmt.Errorf("received %d of %d change events: %w", len(events), n, err)
+		}
+
+		if errs := fetches.Errors(); len(errs) > 0 {
+			return events, fmt.Errorf("failed to consume topic %s: %w", topic, errs[0].Err)
+		}
+
+		var decodeErr error
+		fetches.EachRecord(func(r *kgo.Record) {
+			if decodeErr != nil || r.Value == nil || len(events) == n {
+				return
+			}
+
+			var event ChangeEvent
+			if err := json.Unmarshal(r.Value, &event); err != nil {
+				decodeErr = fmt.Errorf("failed to decode the change event at offset %d: %w", r.Offset, err)
+				return
+			}
+
+			events = append(events, event)
+		})
+		if decodeErr != nil {
+			return events, decodeErr
+		}
+	}
+
+	return events, nil
+}
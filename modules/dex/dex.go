@@ -0,0 +1,218 @@
+package dex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultPort = "5556/tcp"
+	issuerPath  = "/dex"
+
+	configPath = "/etc/dex/testcontainers-config.json"
+	readyPath  = "/etc/dex/testcontainers-config.ready"
+)
+
+// DexContainer represents the Dex container type used in the module
+type DexContainer struct {
+	testcontainers.Container
+	issuerURL string
+}
+
+// RunContainer creates an instance of the Dex container type, using the in-memory storage and
+// the password database, with the static clients and users set by the options.
+// As the issuer URL must be the URL used by the clients running in the host, the configuration
+// is generated once the container is started and its port is mapped.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DexContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "ghcr.io/dexidp/dex:v2.38.0",
+		ExposedPorts: []string{defaultPort},
+		// wait for the configuration, which is copied after the container is started
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd: []string{
+			fmt.Sprintf("while [ ! -f %s ]; do sleep 0.1; done; exec dex serve %s", readyPath, configPath),
+		},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	// the wait strategy can only be checked once the configuration is copied
+	waitStrategy := genericContainerReq.WaitingFor
+	if waitStrategy == nil {
+		waitStrategy = wait.ForHTTP(issuerPath + "/.well-known/openid-configuration").WithPort(defaultPort)
+	}
+	genericContainerReq.WaitingFor = nil
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container host: %w", err)
+	}
+
+	port, err := container.MappedPort(ctx, defaultPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mapped port: %w", err)
+	}
+
+	issuerURL := fmt.Sprintf("http://%s%s", net.JoinHostPort(host, port.Port()), issuerPath)
+
+	cfg, err := renderConfig(issuerURL, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := container.CopyToContainer(ctx, cfg, configPath, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to copy the config into the container: %w", err)
+	}
+
+	if err := container.CopyToContainer(ctx, []byte{}, readyPath, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to copy the config into the container: %w", err)
+	}
+
+	if err := waitStrategy.WaitUntilReady(ctx, container); err != nil {
+		return nil, fmt.Errorf("failed to wait for Dex readiness: %w", err)
+	}
+
+	return &DexContainer{Container: container, issuerURL: issuerURL}, nil
+}
+
+type config struct {
+	Issuer           string           `json:"issuer"`
+	Storage          storageConfig    `json:"storage"`
+	Web              webConfig        `json:"web"`
+	OAuth2           oauth2Config     `json:"oauth2"`
+	EnablePasswordDB bool             `json:"enablePasswordDB"`
+	StaticClients    []clientConfig   `json:"staticClients"`
+	StaticPasswords  []passwordConfig `json:"staticPasswords"`
+}
+
+type storageConfig struct {
+	Type string `json:"type"`
+}
+
+type webConfig struct {
+	HTTP string `json:"http"`
+}
+
+type oauth2Config struct {
+	SkipApprovalScreen bool   `json:"skipApprovalScreen"`
+	PasswordConnector  string `json:"passwordConnector"`
+}
+
+type clientConfig struct {
+	ID           string   `json:"id"`
+	Secret       string   `json:"secret"`
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirectURIs"`
+}
+
+type passwordConfig struct {
+	Email    string `json:"email"`
+	Hash     string `json:"hash"`
+	Username string `json:"username"`
+	UserID   string `json:"userID"`
+}
+
+// renderConfig returns the Dex configuration, encoded as JSON, which is a subset of YAML.
+func renderConfig(issuerURL string, settings options) ([]byte, error) {
+	cfg := config{
+		Issuer:  issuerURL,
+		Storage: storageConfig{Type: "memory"},
+		Web:     webConfig{HTTP: "0.0.0.0:5556"},
+		OAuth2: oauth2Config{
+			SkipApprovalScreen: true,
+			// enables the resource owner password grant for the users of the password database
+			PasswordConnector: "local",
+		},
+		EnablePasswordDB: true,
+		StaticClients:    make([]clientConfig, 0, len(settings.Clients)),
+		StaticPasswords:  make([]passwordConfig, 0, len(settings.Users)),
+	}
+
+	for _, c := range settings.Clients {
+		if c.ID == "" {
+			return nil, errors.New("the client ID cannot be empty")
+		}
+
+		redirectURIs := c.RedirectURIs
+		if redirectURIs == nil {
+			redirectURIs = []string{}
+		}
+
+		cfg.StaticClients = append(cfg.StaticClients, clientConfig{
+			ID:           c.ID,
+			Secret:       c.Secret,
+			Name:         c.Name,
+			RedirectURIs: redirectURIs,
+		})
+	}
+
+	for _, u := range settings.Users {
+		if u.Email == "" || u.Password == "" {
+			return nil, errors.New("the user email and password cannot be empty")
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash the password of %q: %w", u.Email, err)
+		}
+
+		// a stable user ID, derived from the email, so the subject of the tokens is predictable
+		id := sha256.Sum256([]byte(u.Email))
+
+		cfg.StaticPasswords = append(cfg.StaticPasswords, passwordConfig{
+			Email:    u.Email,
+			Hash:     string(hash),
+			Username: u.Username,
+			UserID:   hex.EncodeToString(id[:16]),
+		})
+	}
+
+	return json.Marshal(cfg)
+}
+
+// IssuerURL returns the URL of the OIDC issuer, e.g. "http://localhost:32768/dex", to be used by
+// the OIDC clients and middlewares to discover the provider configuration.
+func (c *DexContainer) IssuerURL() string {
+	return c.issuerURL
+}
+
+// DiscoveryURL returns the URL of the OpenID Provider configuration document.
+func (c *DexContainer) DiscoveryURL() string {
+	return c.issuerURL + "/.well-known/openid-configuration"
+}
+
+// JWKSURL returns the URL of the JSON Web Key Set used to verify the tokens issued by Dex.
+func (c *DexContainer) JWKSURL() string {
+	return c.issuerURL + "/keys"
+}
+
+// TokenURL returns the URL of the token endpoint.
+func (c *DexContainer) TokenURL() string {
+	return c.issuerURL + "/token"
+}
@@ -0,0 +1,154 @@
+package dex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestDex(t *testing.T) {
+	ctx := context.Background()
+
+	// withStaticClientAndUser {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("ghcr.io/dexidp/dex:v2.38.0"),
+		WithStaticClient(Client{
+			ID:           "my-app",
+			Secret:       "my-app-secret",
+			Name:         "My App",
+			RedirectURIs: []string{"http://localhost:8080/callback"},
+		}),
+		WithStaticUser(User{
+			Email:    "jane@example.com",
+			Username: "jane",
+			Password: "password",
+		}),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	t.Run("discovery", func(t *testing.T) {
+		resp, err := http.Get(container.DiscoveryURL())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var discovery struct {
+			Issuer        string `json:"issuer"`
+			JWKSURI       string `json:"jwks_uri"`
+			TokenEndpoint string `json:"token_endpoint"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&discovery))
+
+		// the issuer must match the URL used to discover the provider
+		assert.Equal(t, container.IssuerURL(), discovery.Issuer)
+		assert.Equal(t, container.JWKSURL(), discovery.JWKSURI)
+		assert.Equal(t, container.TokenURL(), discovery.TokenEndpoint)
+	})
+
+	t.Run("jwks", func(t *testing.T) {
+		resp, err := http.Get(container.JWKSURL())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var jwks struct {
+			Keys []json.RawMessage `json:"keys"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&jwks))
+		assert.NotEmpty(t, jwks.Keys)
+	})
+
+	t.Run("password-grant", func(t *testing.T) {
+		// passwordGrant {
+		form := url.Values{
+			"grant_type": {"password"},
+			"username":   {"jane@example.com"},
+			"password":   {"password"},
+			"scope":      {"openid email profile"},
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, container.TokenURL(), strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("my-app", "my-app-secret")
+		// }
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var token struct {
+			IDToken string `json:"id_token"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+		assert.NotEmpty(t, token.IDToken)
+	})
+
+	t.Run("invalid-password", func(t *testing.T) {
+		form := url.Values{
+			"grant_type": {"password"},
+			"username":   {"jane@example.com"},
+			"password":   {"wrong"},
+			"scope":      {"openid"},
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, container.TokenURL(), strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("my-app", "my-app-secret")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestRenderConfig(t *testing.T) {
+	settings := defaultOptions()
+	WithStaticClient(Client{ID: "my-app", Secret: "my-app-secret"})(&settings)
+	WithStaticUser(User{Email: "jane@example.com", Username: "jane", Password: "password"})(&settings)
+
+	data, err := renderConfig("http://localhost:5556/dex", settings)
+	require.NoError(t, err)
+
+	var cfg config
+	require.NoError(t, json.Unmarshal(data, &cfg))
+
+	assert.Equal(t, "http://localhost:5556/dex", cfg.Issuer)
+	assert.Equal(t, "memory", cfg.Storage.Type)
+	require.Len(t, cfg.StaticClients, 1)
+	assert.Equal(t, "my-app", cfg.StaticClients[0].ID)
+	assert.Equal(t, []string{}, cfg.StaticClients[0].RedirectURIs)
+	require.Len(t, cfg.StaticPasswords, 1)
+	assert.Equal(t, "jane@example.com", cfg.StaticPasswords[0].Email)
+	assert.NotEqual(t, "password", cfg.StaticPasswords[0].Hash)
+	assert.NotEmpty(t, cfg.StaticPasswords[0].UserID)
+
+	t.Run("invalid-user", func(t *testing.T) {
+		settings := defaultOptions()
+		WithStaticUser(User{Email: "jane@example.com"})(&settings)
+
+		_, err := renderConfig("http://localhost:5556/dex", settings)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package dex_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/dex"
+)
+
+func ExampleRunContainer() {
+	// runDexContainer {
+	ctx := context.Background()
+
+	dexContainer, err := dex.RunContainer(ctx, testcontainers.WithImage("ghcr.io/dexidp/dex:v2.38.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := dexContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := dexContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
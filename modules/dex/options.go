@@ -0,0 +1,66 @@
+package dex
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// Client is an OAuth2 client registered in Dex when it starts.
+type Client struct {
+	// ID is the client ID.
+	ID string
+	// Secret is the client secret.
+	Secret string
+	// Name is the display name of the client.
+	Name string
+	// RedirectURIs are the allowed redirect URIs of the client.
+	RedirectURIs []string
+}
+
+// User is a user of the Dex password database, which can log in with its email and password.
+type User struct {
+	// Email is the email of the user, used to log in.
+	Email string
+	// Username is the name of the user, returned in the "name" claim.
+	Username string
+	// Password is the plain text password of the user, hashed by the module.
+	Password string
+}
+
+type options struct {
+	Clients []Client
+	Users   []User
+}
+
+func defaultOptions() options {
+	return options{
+		Clients: []Client{},
+		Users:   []User{},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Dex container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithStaticClient registers an OAuth2 client in Dex. It can be called multiple times.
+func WithStaticClient(client Client) Option {
+	return func(o *options) {
+		o.Clients = append(o.Clients, client)
+	}
+}
+
+// WithStaticUser adds a user to the Dex password database, so it can log in with its email
+// and password, using the login form or the resource owner password grant. It can be called
+// multiple times.
+func WithStaticUser(user User) Option {
+	return func(o *options) {
+		o.Users = append(o.Users, user)
+	}
+}
@@ -0,0 +1,295 @@
+package dgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	grpcPort     = "9080/tcp"
+	httpPort     = "8080/tcp"
+	zeroHTTPPort = "6080/tcp"
+
+	zeroAlias  = "zero"
+	alphaAlias = "alpha"
+
+	aclSecretPath = "/dgraph/acl/hmac_secret"
+
+	// DefaultUser is the user created by Dgraph when the ACL is enabled, which is member of the guardians group.
+	DefaultUser = "groot"
+	// DefaultPassword is the initial password of the DefaultUser.
+	DefaultPassword = "password"
+)
+
+// DgraphContainer represents the Dgraph container type used in the module. The embedded container
+// is the alpha, and the zero is attached to the same network, created by the module.
+type DgraphContainer struct {
+	testcontainers.Container
+	Zero    testcontainers.Container
+	network *testcontainers.DockerNetwork
+	acl     bool
+}
+
+// RunContainer creates an instance of the Dgraph container type, running a zero and an alpha attached
+// to a network created by the module. The options are applied to the alpha, and the zero uses the same image.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DgraphContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "dgraph/dgraph:v23.1.0",
+		ExposedPorts: []string{grpcPort, httpPort},
+		Cmd: []string{
+			"dgraph", "alpha",
+			"--my=" + alphaAlias + ":7080",
+			"--zero=" + zeroAlias + ":5080",
+			"--security", "whitelist=0.0.0.0/0",
+		},
+		WaitingFor: wait.ForHTTP("/health").
+			WithPort(httpPort).
+			WithStartupTimeout(2 * time.Minute).
+			WithResponseMatcher(func(body io.Reader) bool {
+				data, err := io.ReadAll(body)
+				if err != nil {
+					return false
+				}
+
+				return bytes.Contains(data, []byte(`"status":"healthy"`))
+			}),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.ACLSecret != "" {
+		if len(settings.ACLSecret) < 32 {
+			return nil, errors.New("the ACL secret must be at least 32 characters long")
+		}
+
+		secret := []byte(settings.ACLSecret)
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, secret, aclSecretPath, 0o600)
+				},
+			},
+		})
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--acl", "secret-file="+aclSecretPath)
+	}
+
+	if settings.GrootPassword != "" && settings.ACLSecret == "" {
+		return nil, errors.New("the password of the groot user requires the ACL, use the WithACL option")
+	}
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	c := &DgraphContainer{network: nw, acl: settings.ACLSecret != ""}
+
+	zero, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        genericContainerReq.Image,
+			ExposedPorts: []string{zeroHTTPPort},
+			Cmd:          []string{"dgraph", "zero", "--my=" + zeroAlias + ":5080"},
+			Networks:     []string{nw.Name},
+			NetworkAliases: map[string][]string{
+				nw.Name: {zeroAlias},
+			},
+			WaitingFor: wait.ForHTTP("/health").WithPort(zeroHTTPPort).WithStartupTimeout(time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start the zero: %w", err)
+	}
+	c.Zero = zero
+
+	network.WithNetwork([]string{alphaAlias}, nw)(&genericContainerReq)
+
+	alpha, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+	c.Container = alpha
+
+	if settings.GrootPassword != "" {
+		if err := c.changeGrootPassword(ctx, settings.GrootPassword); err != nil {
+			_ = c.Terminate(ctx)
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// changeGrootPassword replaces the default password of the groot user.
+func (c *DgraphContainer) changeGrootPassword(ctx context.Context, password string) error {
+	token, err := c.Login(ctx, DefaultUser, DefaultPassword)
+	if err != nil {
+		return err
+	}
+
+	query := `mutation($password: String!) {
+		updateUser(input: {filter: {name: {eq: "` + DefaultUser + `"}}, set: {password: $password}}) {
+			user { name }
+		}
+	}`
+
+	if err := c.admin(ctx, token, query, map[string]interface{}{"password": password}, nil); err != nil {
+		return fmt.Errorf("failed to change the password of %s: %w", DefaultUser, err)
+	}
+
+	return nil
+}
+
+// GRPCAddress returns the "host:port" address of the gRPC endpoint of the alpha, to be used by the dgo client.
+func (c *DgraphContainer) GRPCAddress(ctx context.Context) (string, error) {
+	return c.address(ctx, grpcPort)
+}
+
+// HTTPURL returns the URL of the HTTP endpoint of the alpha, e.g. "http://localhost:32768",
+// serving the /query, /mutate, /alter, /graphql and /admin endpoints.
+func (c *DgraphContainer) HTTPURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// Login logs in the given user, returning the access JWT to be sent in the X-Dgraph-AccessToken header
+// of the HTTP requests. It returns an error if the ACL is not enabled with the WithACL option.
+func (c *DgraphContainer) Login(ctx context.Context, userID string, password string) (string, error) {
+	if !c.acl {
+		return "", errors.New("the ACL is not enabled")
+	}
+
+	query := `mutation($userId: String!, $password: String!) {
+		login(userId: $userId, password: $password) {
+			response { accessJWT }
+		}
+	}`
+
+	var data struct {
+		Login struct {
+			Response struct {
+				AccessJWT string `json:"accessJWT"`
+			} `json:"response"`
+		} `json:"login"`
+	}
+
+	err := c.admin(ctx, "", query, map[string]interface{}{"userId": userID, "password": password}, &data)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in %s: %w", userID, err)
+	}
+
+	return data.Login.Response.AccessJWT, nil
+}
+
+// admin sends a GraphQL operation to the /admin endpoint, decoding the data of the response into data, if not nil.
+func (c *DgraphContainer) admin(ctx context.Context, token string, query string, variables map[string]interface{}, data interface{}) error {
+	baseURL, err := c.HTTPURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/admin", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Dgraph-AccessToken", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	if len(result.Errors) > 0 {
+		return errors.New(result.Errors[0].Message)
+	}
+
+	if data != nil {
+		return json.Unmarshal(result.Data, data)
+	}
+
+	return nil
+}
+
+// Terminate terminates the alpha and the zero containers, and removes the network.
+func (c *DgraphContainer) Terminate(ctx context.Context) error {
+	if c.Container != nil {
+		if err := c.Container.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Zero != nil {
+		if err := c.Zero.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.network != nil {
+		return c.network.Remove(ctx)
+	}
+
+	return nil
+}
+
+func (c *DgraphContainer) address(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
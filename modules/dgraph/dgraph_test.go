@@ -0,0 +1,144 @@
+package dgraph_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/dgraph"
+)
+
+func TestDgraph(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := dgraph.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// httpURL {
+	httpURL, err := container.HTTPURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	post(t, httpURL+"/alter", "", "", "name: string @index(exact) .")
+	post(t, httpURL+"/mutate?commitNow=true", "", "application/rdf", `{ set { _:alice <name> "Alice" . } }`)
+
+	body := post(t, httpURL+"/query", "", "application/dql", `{ people(func: eq(name, "Alice")) { name } }`)
+	if !strings.Contains(body, `"name":"Alice"`) {
+		t.Fatalf("expected Alice in the response, got %s", body)
+	}
+
+	// grpcAddress {
+	grpcAddress, err := container.GRPCAddress(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if grpcAddress == "" {
+		t.Fatal("expected the gRPC address")
+	}
+
+	if _, err := container.Login(ctx, dgraph.DefaultUser, dgraph.DefaultPassword); err == nil {
+		t.Fatal("expected an error, as the ACL is not enabled")
+	}
+}
+
+func TestDgraphWithACL(t *testing.T) {
+	ctx := context.Background()
+
+	// withACL {
+	container, err := dgraph.RunContainer(ctx,
+		dgraph.WithACL("a-secret-of-at-least-32-characters"),
+		dgraph.WithGrootPassword("s3cr3t-p4ssw0rd"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if _, err := container.Login(ctx, dgraph.DefaultUser, dgraph.DefaultPassword); err == nil {
+		t.Fatal("expected an error with the default password")
+	}
+
+	// login {
+	token, err := container.Login(ctx, dgraph.DefaultUser, "s3cr3t-p4ssw0rd")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpURL, err := container.HTTPURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	post(t, httpURL+"/alter", token, "", "name: string .")
+}
+
+func TestDgraphWithInvalidACLSecret(t *testing.T) {
+	_, err := dgraph.RunContainer(context.Background(), dgraph.WithACL("too-short"))
+	if err == nil {
+		t.Fatal("expected an error for a secret shorter than 32 characters")
+	}
+}
+
+// post sends a request to the HTTP endpoint of the alpha, failing the test if the response contains errors.
+func post(t *testing.T, url string, token string, contentType string, body string) string {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("X-Dgraph-AccessToken", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result struct {
+		Errors json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors for %s: %s", url, string(result.Errors))
+	}
+
+	return string(data)
+}
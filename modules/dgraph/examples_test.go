@@ -0,0 +1,37 @@
+package dgraph_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/dgraph"
+)
+
+func ExampleRunContainer() {
+	// runDgraphContainer {
+	ctx := context.Background()
+
+	dgraphContainer, err := dgraph.RunContainer(ctx, testcontainers.WithImage("dgraph/dgraph:v23.1.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := dgraphContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := dgraphContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,44 @@
+package dgraph
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// ACLSecret is the HMAC secret signing the JWTs of the users, which enables the ACL when set.
+	ACLSecret string
+	// GrootPassword is the password of the groot user, replacing the default one once the alpha is ready.
+	GrootPassword string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Dgraph container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithACL enables the access control lists of the alpha, using the given HMAC secret,
+// at least 32 characters long, to sign the JWTs of the users. Dgraph creates the groot user,
+// with the DefaultPassword, and the guardians group on startup.
+func WithACL(secret string) Option {
+	return func(o *options) {
+		o.ACLSecret = secret
+	}
+}
+
+// WithGrootPassword replaces the default password of the groot user once the alpha is ready.
+// It requires the WithACL option.
+func WithGrootPassword(password string) Option {
+	return func(o *options) {
+		o.GrootPassword = password
+	}
+}
@@ -0,0 +1,220 @@
+package druid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const routerPort = "8888/tcp"
+
+// startScript starts ZooKeeper, from the jars bundled with Druid, and the Druid services of the
+// single-server micro-quickstart configuration, as the image runs a single service by default.
+const startScript = `cd /opt/druid
+conf=conf/druid/single-server/micro-quickstart
+mkdir -p var/tmp var/zk
+bin/run-java -Dzookeeper.admin.enableServer=false -cp 'lib/*' org.apache.zookeeper.server.ZooKeeperServerMain 2181 var/zk &
+for service in coordinator-overlord broker historical middleManager router; do
+  bin/run-java $(xargs < $conf/$service/jvm.config) -cp "$conf/_common:$conf/$service:lib/*" org.apache.druid.cli.Main server ${service%-overlord} &
+done
+wait`
+
+// DruidContainer represents the Druid container type used in the module
+type DruidContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Druid container type, running the services of the single-server
+// micro-quickstart configuration in the same container: ZooKeeper, the coordinator acting as overlord,
+// the broker, the historical, the middle manager and the router. The metadata are stored in Derby, and
+// the segments in the local deep storage. The container is ready once the router can reach the services.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*DruidContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apache/druid:28.0.1",
+		ExposedPorts: []string{routerPort},
+		Entrypoint:   []string{"/bin/sh", "-c"},
+		Cmd:          []string{startScript},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/status/health").WithPort(routerPort),
+			// the router proxies the management APIs of the coordinator and the overlord, and the queries to the broker
+			wait.ForHTTP("/druid/coordinator/v1/isLeader").WithPort(routerPort),
+			wait.ForHTTP("/druid/indexer/v1/isLeader").WithPort(routerPort),
+			wait.ForHTTP("/druid/v2/datasources").WithPort(routerPort),
+		).WithDeadline(3 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DruidContainer{Container: container}, nil
+}
+
+// RouterURL returns the base URL of the router, e.g. "http://localhost:32768", which serves the web console,
+// the SQL and native query APIs, and the management APIs of the coordinator and the overlord.
+func (c *DruidContainer) RouterURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, routerPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// SubmitTask submits an ingestion spec, e.g. an "index_parallel" task, to the overlord, returning the ID of the task.
+func (c *DruidContainer) SubmitTask(ctx context.Context, spec string) (string, error) {
+	respBody, err := c.do(ctx, http.MethodPost, "/druid/indexer/v1/task", []byte(spec))
+	if err != nil {
+		return "", err
+	}
+
+	var task struct {
+		Task string `json:"task"`
+	}
+	if err := json.Unmarshal(respBody, &task); err != nil {
+		return "", err
+	}
+
+	return task.Task, nil
+}
+
+// WaitForTask waits until the task with the given ID is complete. It returns an error if the task failed,
+// or if the context is done before the task completes.
+func (c *DruidContainer) WaitForTask(ctx context.Context, taskID string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		respBody, err := c.do(ctx, http.MethodGet, "/druid/indexer/v1/task/"+url.PathEscape(taskID)+"/status", nil)
+		if err != nil {
+			return err
+		}
+
+		var status struct {
+			Status struct {
+				Status   string `json:"status"`
+				ErrorMsg string `json:"errorMsg"`
+			} `json:"status"`
+		}
+		if err := json.Unmarshal(respBody, &status); err != nil {
+			return err
+		}
+
+		switch status.Status.Status {
+		case "SUCCESS":
+			return nil
+		case "FAILED":
+			return fmt.Errorf("task %s failed: %s", taskID, status.Status.ErrorMsg)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("task %s is not complete: %w", taskID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForDatasource waits until all the segments of the datasource are loaded by the historical,
+// so the ingested data are visible to the queries.
+func (c *DruidContainer) WaitForDatasource(ctx context.Context, datasource string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		respBody, err := c.do(ctx, http.MethodGet, "/druid/coordinator/v1/loadstatus", nil)
+		if err != nil {
+			return err
+		}
+
+		var loadStatus map[string]float64
+		if err := json.Unmarshal(respBody, &loadStatus); err != nil {
+			return err
+		}
+
+		if percentage, ok := loadStatus[datasource]; ok && percentage >= 100 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("datasource %s is not loaded: %w", datasource, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// SQL runs a Druid SQL query, returning the rows of the result as objects keyed by the column names.
+func (c *DruidContainer) SQL(ctx context.Context, query string) ([]map[string]interface{}, error) {
+	reqBody, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/druid/v2/sql", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(respBody, &rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (c *DruidContainer) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	routerURL, err := c.RouterURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, routerURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,97 @@
+package druid_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/druid"
+)
+
+const ingestionSpec = `{
+  "type": "index_parallel",
+  "spec": {
+    "dataSchema": {
+      "dataSource": "clicks",
+      "timestampSpec": {"column": "ts", "format": "iso"},
+      "dimensionsSpec": {"dimensions": ["country", {"type": "long", "name": "clicks"}]},
+      "granularitySpec": {"segmentGranularity": "day", "queryGranularity": "none", "rollup": false}
+    },
+    "ioConfig": {
+      "type": "index_parallel",
+      "inputSource": {
+        "type": "inline",
+        "data": "{\"ts\": \"2024-01-01T10:00:00Z\", \"country\": \"es\", \"clicks\": 3}\n{\"ts\": \"2024-01-01T11:00:00Z\", \"country\": \"fr\", \"clicks\": 5}\n{\"ts\": \"2024-01-01T12:00:00Z\", \"country\": \"es\", \"clicks\": 4}"
+      },
+      "inputFormat": {"type": "json"}
+    },
+    "tuningConfig": {"type": "index_parallel"}
+  }
+}`
+
+func TestDruid(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := druid.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// routerURL {
+	routerURL, err := container.RouterURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(routerURL + "/status/health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the router health", resp.StatusCode)
+	}
+
+	// submitTask {
+	taskID, err := container.SubmitTask(ctx, ingestionSpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 3*time.Minute)
+	defer cancel()
+
+	if err := container.WaitForTask(waitCtx, taskID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := container.WaitForDatasource(waitCtx, "clicks"); err != nil {
+		t.Fatal(err)
+	}
+	// }
+
+	// sql {
+	rows, err := container.SQL(ctx, "SELECT country, SUM(clicks) AS clicks FROM clicks GROUP BY country ORDER BY country")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %v", rows)
+	}
+
+	if rows[0]["country"] != "es" || rows[0]["clicks"] != 7.0 {
+		t.Fatalf("expected 7 clicks for es, got %v", rows[0])
+	}
+}
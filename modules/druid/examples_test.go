@@ -0,0 +1,37 @@
+package druid_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/druid"
+)
+
+func ExampleRunContainer() {
+	// runDruidContainer {
+	ctx := context.Background()
+
+	druidContainer, err := druid.RunContainer(ctx, testcontainers.WithImage("apache/druid:28.0.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := druidContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := druidContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
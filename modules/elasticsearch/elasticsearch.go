@@ -123,9 +123,10 @@ func configureAddress(ctx context.Context, c *ElasticsearchContainer) (string, e
 
 // configureCertificate transfers the certificate settings to the container request.
 // For that, it defines a post start hook that copies the certificate from the container to the host.
-// The certificate is only available since version 8, and will be located in a well-known location.
+// The certificate is only available since version 8, and will be located in a well-known location,
+// unless security has been explicitly disabled, in which case it's not generated.
 func configureCertificate(settings *Options, req *testcontainers.GenericContainerRequest) error {
-	if isAtLeastVersion(req.Image, 8) {
+	if isAtLeastVersion(req.Image, 8) && !isSecurityDisabled(req) {
 		// The container needs a post start hook to copy the certificate from the container to the host.
 		// This certificate is only available since version 8
 		req.LifecycleHooks[0].PostStarts = append(req.LifecycleHooks[0].PostStarts,
@@ -155,8 +156,8 @@ func configureCertificate(settings *Options, req *testcontainers.GenericContaine
 // configurePassword transfers the password settings to the container request.
 // If the password is not set, it will be set to "changeme" for Elasticsearch 8
 func configurePassword(settings *Options, req *testcontainers.GenericContainerRequest) error {
-	// set "changeme" as default password for Elasticsearch 8
-	if isAtLeastVersion(req.Image, 8) && settings.Password == "" {
+	// set "changeme" as default password for Elasticsearch 8, unless security has been explicitly disabled
+	if isAtLeastVersion(req.Image, 8) && !isSecurityDisabled(req) && settings.Password == "" {
 		WithPassword(defaultPassword)(settings)
 	}
 
@@ -178,6 +179,12 @@ func configurePassword(settings *Options, req *testcontainers.GenericContainerRe
 	return nil
 }
 
+// isSecurityDisabled returns true if security has been explicitly disabled for the container,
+// setting the xpack.security.enabled environment variable to false.
+func isSecurityDisabled(req *testcontainers.GenericContainerRequest) bool {
+	return req.Env["xpack.security.enabled"] == "false"
+}
+
 // configureJvmOpts sets the default memory of the Elasticsearch instance to 2GB.
 // This functions, which is only available since version 7, is called as a post create hook
 // for the container request.
@@ -204,6 +204,47 @@ func TestElasticsearch8WithoutCredentials(t *testing.T) {
 	}
 }
 
+func TestElasticsearch8WithoutSecurity(t *testing.T) {
+	ctx := context.Background()
+
+	// withoutSecurity {
+	container, err := elasticsearch.RunContainer(ctx,
+		testcontainers.WithImage(baseImage8),
+		testcontainers.CustomizeRequestOption(func(req *testcontainers.GenericContainerRequest) {
+			req.Env["xpack.security.enabled"] = "false"
+		}),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if container.Settings.CACert != nil {
+		t.Fatal("expected no CA certificate when security is disabled")
+	}
+
+	resp, err := http.Get(container.Settings.Address)
+	if err != nil {
+		t.Fatal(err, "Should be able to access / URI over HTTP without credentials.")
+	}
+	defer resp.Body.Close()
+
+	var esResp ElasticsearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&esResp); err != nil {
+		t.Fatal(err)
+	}
+
+	if esResp.Tagline != "You Know, for Search" {
+		t.Fatal("expected tagline to be 'You Know, for Search' but got", esResp.Tagline)
+	}
+}
+
 func TestElasticsearchOSSCannotuseWithPassword(t *testing.T) {
 	ctx := context.Background()
 
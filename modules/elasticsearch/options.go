@@ -8,9 +8,14 @@ import (
 // It could be used to build an HTTP client for the Elasticsearch container, as it will
 // hold information on how to connect to the container.
 type Options struct {
-	Address  string
-	CACert   []byte
+	// Address is the URL of the HTTP API, using https when the CA certificate is available.
+	Address string
+	// CACert holds the PEM encoded CA certificate that signed the HTTP certificate, which is
+	// generated by Elasticsearch 8 on startup, when security is enabled. It's nil otherwise.
+	CACert []byte
+	// Password is the password of the elastic user.
 	Password string
+	// Username is the name of the built-in superuser, elastic.
 	Username string
 }
 
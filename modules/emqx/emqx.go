@@ -0,0 +1,272 @@
+package emqx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultDashboardUsername = "admin"
+	defaultDashboardPassword = "public"
+
+	mqttPort               = "1883/tcp"
+	mqttsPort              = "8883/tcp"
+	websocketPort          = "8083/tcp"
+	secureWebsocketPort    = "8084/tcp"
+	dashboardPort          = "18083/tcp"
+	apiKeysBootstrapPath   = "/opt/emqx/etc/api_keys.bootstrap"
+	builtInDatabaseAuthnID = "password_based:built_in_database"
+)
+
+// EMQXContainer represents the EMQX container type used in the module
+type EMQXContainer struct {
+	testcontainers.Container
+	settings options
+}
+
+// RunContainer creates an instance of the EMQX container type, exposing the MQTT, MQTT over TLS,
+// websockets, secure websockets and dashboard listeners. The TLS listeners use the self-signed
+// certificate shipped with the image.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*EMQXContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "emqx/emqx:5.4.1",
+		ExposedPorts: []string{mqttPort, mqttsPort, websocketPort, secureWebsocketPort, dashboardPort},
+		Env:          map[string]string{},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/status").WithPort(dashboardPort),
+			wait.ForListeningPort(mqttPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.DashboardUsername == "" || settings.DashboardPassword == "" {
+		return nil, errors.New("the dashboard username and password cannot be empty")
+	}
+
+	genericContainerReq.Env["EMQX_DASHBOARD__DEFAULT_USERNAME"] = settings.DashboardUsername
+	genericContainerReq.Env["EMQX_DASHBOARD__DEFAULT_PASSWORD"] = settings.DashboardPassword
+
+	if len(settings.APIKeys) > 0 {
+		keys := make([]string, 0, len(settings.APIKeys))
+		for key := range settings.APIKeys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		// one "key:secret" entry per line
+		var buf bytes.Buffer
+		for _, key := range keys {
+			if key == "" || strings.ContainsAny(key, ":\n") {
+				return nil, fmt.Errorf("invalid API key: %q", key)
+			}
+			buf.WriteString(key + ":" + settings.APIKeys[key] + "\n")
+		}
+		bootstrap := buf.Bytes()
+
+		genericContainerReq.Env["EMQX_API_KEY__BOOTSTRAP_FILE"] = apiKeysBootstrapPath
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, bootstrap, apiKeysBootstrapPath, 0o644)
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &EMQXContainer{Container: container, settings: settings}
+
+	if len(settings.Users) > 0 {
+		if err := c.createUsers(ctx); err != nil {
+			return nil, fmt.Errorf("failed to create users: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// createUsers adds the built-in database authenticator, and the users to it, using the REST management API.
+func (c *EMQXContainer) createUsers(ctx context.Context) error {
+	token, err := c.login(ctx)
+	if err != nil {
+		return err
+	}
+
+	authenticator := map[string]any{
+		"mechanism":    "password_based",
+		"backend":      "built_in_database",
+		"user_id_type": "username",
+		"password_hash_algorithm": map[string]any{
+			"name":          "sha256",
+			"salt_position": "suffix",
+		},
+	}
+	if err := c.apiRequest(ctx, token, "/authentication", authenticator, nil); err != nil {
+		return err
+	}
+
+	usernames := make([]string, 0, len(c.settings.Users))
+	for username := range c.settings.Users {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+
+	for _, username := range usernames {
+		user := map[string]any{
+			"user_id":  username,
+			"password": c.settings.Users[username],
+		}
+		if err := c.apiRequest(ctx, token, "/authentication/"+builtInDatabaseAuthnID+"/users", user, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// login logs the dashboard administrator in the REST management API, returning the bearer token.
+func (c *EMQXContainer) login(ctx context.Context) (string, error) {
+	credentials := map[string]string{
+		"username": c.settings.DashboardUsername,
+		"password": c.settings.DashboardPassword,
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := c.apiRequest(ctx, "", "/login", credentials, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Token, nil
+}
+
+// apiRequest sends a POST request with the given JSON body to the REST management API,
+// decoding the JSON response in out, if not nil.
+func (c *EMQXContainer) apiRequest(ctx context.Context, token string, path string, body any, out any) error {
+	apiURL, err := c.APIURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, path, string(respBody))
+	}
+
+	if out != nil {
+		return json.Unmarshal(respBody, out)
+	}
+
+	return nil
+}
+
+// BrokerURL returns the URL of the MQTT listener, e.g. "tcp://localhost:32768", to be used by the MQTT clients.
+func (c *EMQXContainer) BrokerURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "tcp", mqttPort, "")
+}
+
+// TLSBrokerURL returns the URL of the MQTT over TLS listener, e.g. "ssl://localhost:32768".
+// The listener uses the self-signed certificate shipped with the image, so the clients must
+// trust it, or skip the verification.
+func (c *EMQXContainer) TLSBrokerURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "ssl", mqttsPort, "")
+}
+
+// WebSocketURL returns the URL of the websockets listener, e.g. "ws://localhost:32768/mqtt".
+func (c *EMQXContainer) WebSocketURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "ws", websocketPort, "/mqtt")
+}
+
+// SecureWebSocketURL returns the URL of the secure websockets listener, e.g. "wss://localhost:32768/mqtt".
+// The listener uses the self-signed certificate shipped with the image.
+func (c *EMQXContainer) SecureWebSocketURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "wss", secureWebsocketPort, "/mqtt")
+}
+
+// DashboardURL returns the URL of the dashboard, e.g. "http://localhost:32768".
+func (c *EMQXContainer) DashboardURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "http", dashboardPort, "")
+}
+
+// APIURL returns the base URL of the REST management API, e.g. "http://localhost:32768/api/v5".
+func (c *EMQXContainer) APIURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "http", dashboardPort, "/api/v5")
+}
+
+// DashboardUsername returns the username of the dashboard administrator.
+func (c *EMQXContainer) DashboardUsername() string {
+	return c.settings.DashboardUsername
+}
+
+// DashboardPassword returns the password of the dashboard administrator.
+func (c *EMQXContainer) DashboardPassword() string {
+	return c.settings.DashboardPassword
+}
+
+func (c *EMQXContainer) endpoint(ctx context.Context, scheme string, port nat.Port, path string) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort(host, mappedPort.Port()), path), nil
+}
@@ -0,0 +1,195 @@
+package emqx
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestEMQX(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("emqx/emqx:5.4.1"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		// brokerURL {
+		brokerURL, err := container.BrokerURL(ctx)
+		// }
+		require.NoError(t, err)
+
+		client := connect(t, mqtt.NewClientOptions().AddBroker(brokerURL))
+		defer client.Disconnect(250)
+	})
+
+	t.Run("tls", func(t *testing.T) {
+		tlsBrokerURL, err := container.TLSBrokerURL(ctx)
+		require.NoError(t, err)
+
+		// the listener uses the self-signed certificate shipped with the image
+		client := connect(t, mqtt.NewClientOptions().AddBroker(tlsBrokerURL).SetTLSConfig(&tls.Config{InsecureSkipVerify: true}))
+		defer client.Disconnect(250)
+	})
+
+	t.Run("websockets", func(t *testing.T) {
+		webSocketURL, err := container.WebSocketURL(ctx)
+		require.NoError(t, err)
+
+		client := connect(t, mqtt.NewClientOptions().AddBroker(webSocketURL))
+		defer client.Disconnect(250)
+	})
+
+	t.Run("secure-websockets", func(t *testing.T) {
+		secureWebSocketURL, err := container.SecureWebSocketURL(ctx)
+		require.NoError(t, err)
+
+		client := connect(t, mqtt.NewClientOptions().AddBroker(secureWebSocketURL).SetTLSConfig(&tls.Config{InsecureSkipVerify: true}))
+		defer client.Disconnect(250)
+	})
+
+	t.Run("shared-subscription", func(t *testing.T) {
+		brokerURL, err := container.BrokerURL(ctx)
+		require.NoError(t, err)
+
+		var mu sync.Mutex
+		received := make(map[string]int)
+		var wg sync.WaitGroup
+		wg.Add(10)
+
+		for i := 0; i < 2; i++ {
+			subscriber := connect(t, mqtt.NewClientOptions().AddBroker(brokerURL).SetClientID(fmt.Sprintf("subscriber-%d", i)))
+			defer subscriber.Disconnect(250)
+
+			// sharedSubscription {
+			token := subscriber.Subscribe("$share/workers/jobs", 1, func(_ mqtt.Client, msg mqtt.Message) {
+				mu.Lock()
+				defer mu.Unlock()
+				received[string(msg.Payload())]++
+				wg.Done()
+			})
+			// }
+			require.True(t, token.WaitTimeout(10*time.Second))
+			require.NoError(t, token.Error())
+		}
+
+		publisher := connect(t, mqtt.NewClientOptions().AddBroker(brokerURL).SetClientID("publisher"))
+		defer publisher.Disconnect(250)
+
+		for i := 0; i < 10; i++ {
+			token := publisher.Publish("jobs", 1, false, fmt.Sprintf("job-%d", i))
+			require.True(t, token.WaitTimeout(10*time.Second))
+			require.NoError(t, token.Error())
+		}
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the messages")
+		}
+
+		// each message is delivered to only one of the subscribers of the group
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Len(t, received, 10)
+		for payload, count := range received {
+			assert.Equal(t, 1, count, payload)
+		}
+	})
+}
+
+func TestEMQXWithUsers(t *testing.T) {
+	ctx := context.Background()
+
+	// withUser {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("emqx/emqx:5.4.1"),
+		WithDashboardCredentials("root", "s3cr3tpassw0rd"),
+		WithUser("sensor", "s3cr3t"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	brokerURL, err := container.BrokerURL(ctx)
+	require.NoError(t, err)
+
+	t.Run("valid-credentials", func(t *testing.T) {
+		client := connect(t, mqtt.NewClientOptions().AddBroker(brokerURL).SetUsername("sensor").SetPassword("s3cr3t"))
+		defer client.Disconnect(250)
+	})
+
+	t.Run("invalid-credentials", func(t *testing.T) {
+		client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(brokerURL).SetUsername("sensor").SetPassword("wrong"))
+		token := client.Connect()
+		require.True(t, token.WaitTimeout(10*time.Second))
+		require.Error(t, token.Error())
+	})
+}
+
+func TestEMQXWithAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	// withAPIKey {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("emqx/emqx:5.4.1"),
+		WithAPIKey("testcontainers", "s3cr3t"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// apiURL {
+	apiURL, err := container.APIURL(ctx)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+"/clients", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("testcontainers", "s3cr3t")
+	// }
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func connect(t *testing.T, opts *mqtt.ClientOptions) mqtt.Client {
+	t.Helper()
+
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	require.True(t, token.WaitTimeout(10*time.Second))
+	require.NoError(t, token.Error())
+
+	return client
+}
@@ -0,0 +1,37 @@
+package emqx_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/emqx"
+)
+
+func ExampleRunContainer() {
+	// runEMQXContainer {
+	ctx := context.Background()
+
+	emqxContainer, err := emqx.RunContainer(ctx, testcontainers.WithImage("emqx/emqx:5.4.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := emqxContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := emqxContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,63 @@
+package emqx
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// DashboardUsername and DashboardPassword are the credentials of the dashboard administrator,
+	// also used to authenticate on the REST management API.
+	DashboardUsername string
+	DashboardPassword string
+	// APIKeys is a map of API keys (key) and their secrets (value), bootstrapped when the broker starts.
+	APIKeys map[string]string
+	// Users is a map of usernames (key) and passwords (value) of the MQTT clients, added to the
+	// built-in database authenticator.
+	Users map[string]string
+}
+
+func defaultOptions() options {
+	return options{
+		DashboardUsername: defaultDashboardUsername,
+		DashboardPassword: defaultDashboardPassword,
+		APIKeys:           make(map[string]string),
+		Users:             make(map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the EMQX container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithDashboardCredentials sets the credentials of the dashboard administrator, which can also be
+// used to log in the REST management API. If they are not specified, then "admin" and "public" are used.
+func WithDashboardCredentials(username string, password string) Option {
+	return func(o *options) {
+		o.DashboardUsername = username
+		o.DashboardPassword = password
+	}
+}
+
+// WithAPIKey bootstraps an API key with the given secret when the broker starts, to be used as
+// HTTP Basic credentials on the REST management API. It can be called multiple times.
+func WithAPIKey(key string, secret string) Option {
+	return func(o *options) {
+		o.APIKeys[key] = secret
+	}
+}
+
+// WithUser enables the password based authentication of the MQTT clients, backed by the built-in
+// database, and adds the given user to it. It can be called multiple times.
+// Once enabled, the clients without valid credentials are rejected.
+func WithUser(username string, password string) Option {
+	return func(o *options) {
+		o.Users[username] = password
+	}
+}
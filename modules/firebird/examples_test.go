@@ -0,0 +1,36 @@
+package firebird_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go/modules/firebird"
+)
+
+func ExampleRunContainer() {
+	// runFirebirdContainer {
+	ctx := context.Background()
+
+	firebirdContainer, err := firebird.RunContainer(ctx, firebird.WithSysdbaPassword("s3cr3t"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := firebirdContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := firebirdContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,109 @@
+package firebird
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	port = "3050/tcp"
+
+	dataDir = "/firebird/data"
+)
+
+const (
+	// SysdbaUser is the user of the Firebird administrator account.
+	SysdbaUser = "SYSDBA"
+	// DefaultSysdbaPassword is the default password of the SYSDBA account, which is the historical default of Firebird.
+	DefaultSysdbaPassword = "masterkey"
+	// DefaultDatabase is the default name of the database file created when the container starts.
+	DefaultDatabase = "test.fdb"
+)
+
+// FirebirdContainer represents the Firebird container type used in the module
+type FirebirdContainer struct {
+	testcontainers.Container
+	sysdbaPassword string
+	database       string
+}
+
+// RunContainer creates an instance of the Firebird container type, creating a database file owned by
+// the SYSDBA account in the /firebird/data directory when the container starts.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*FirebirdContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "jacobalberty/firebird:v4.0.1",
+		ExposedPorts: []string{port},
+		WaitingFor:   wait.ForListeningPort(port).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env == nil {
+		genericContainerReq.Env = map[string]string{}
+	}
+	genericContainerReq.Env["ISC_PASSWORD"] = settings.SysdbaPassword
+	genericContainerReq.Env["FIREBIRD_DATABASE"] = settings.Database
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirebirdContainer{
+		Container:      container,
+		sysdbaPassword: settings.SysdbaPassword,
+		database:       settings.Database,
+	}, nil
+}
+
+// SysdbaPassword returns the password of the SYSDBA account.
+func (c *FirebirdContainer) SysdbaPassword() string {
+	return c.sysdbaPassword
+}
+
+// DatabasePath returns the path of the database file in the container, e.g. "/firebird/data/test.fdb".
+func (c *FirebirdContainer) DatabasePath() string {
+	return dataDir + "/" + c.database
+}
+
+// Address returns the "host:port" address of the Firebird server.
+func (c *FirebirdContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
+
+// ConnectionString returns the DSN to connect to the database as the SYSDBA account, in the format expected
+// by the github.com/nakagami/firebirdsql driver, e.g. "SYSDBA:masterkey@localhost:32768//firebird/data/test.fdb".
+func (c *FirebirdContainer) ConnectionString(ctx context.Context) (string, error) {
+	address, err := c.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s@%s/%s", SysdbaUser, c.sysdbaPassword, address, c.DatabasePath()), nil
+}
@@ -0,0 +1,81 @@
+package firebird_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/modules/firebird"
+)
+
+func TestFirebird(t *testing.T) {
+	ctx := context.Background()
+
+	// withOptions {
+	container, err := firebird.RunContainer(ctx,
+		firebird.WithSysdbaPassword("s3cr3t"),
+		firebird.WithDatabase("legacy.fdb"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(connStr, "SYSDBA:s3cr3t@") || !strings.HasSuffix(connStr, "//firebird/data/legacy.fdb") {
+		t.Fatalf("unexpected connection string %s", connStr)
+	}
+
+	out := isql(t, container, container.SysdbaPassword(), `
+CREATE TABLE customers (id INTEGER NOT NULL PRIMARY KEY, name VARCHAR(50));
+INSERT INTO customers VALUES (1, 'legacy-customer');
+COMMIT;
+SELECT name FROM customers WHERE id = 1;
+`)
+	if !strings.Contains(out, "legacy-customer") {
+		t.Fatalf("expected the customer in the query output, got %s", out)
+	}
+
+	// the server authenticates the SYSDBA account with the configured password
+	out = isql(t, container, firebird.DefaultSysdbaPassword, "SELECT 1 FROM rdb$database;")
+	if !strings.Contains(out, "password") {
+		t.Fatalf("expected the default password to be rejected, got %s", out)
+	}
+}
+
+// isql runs the statements with the isql tool of the container, connecting to the database through the server.
+func isql(t *testing.T, container *firebird.FirebirdContainer, password string, statements string) string {
+	t.Helper()
+
+	// the statements are sent to the standard input of isql
+	script := fmt.Sprintf("/usr/local/firebird/bin/isql -q -user %s -password '%s' localhost:%s <<'EOF'\n%s\nEOF",
+		firebird.SysdbaUser, password, container.DatabasePath(), statements)
+
+	_, output, err := container.Exec(context.Background(), []string{"sh", "-c", script}, exec.Multiplexed())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := io.ReadAll(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(out)
+}
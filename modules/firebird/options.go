@@ -0,0 +1,45 @@
+package firebird
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// SysdbaPassword is the password of the SYSDBA account.
+	SysdbaPassword string
+	// Database is the name of the database file created when the container starts.
+	Database string
+}
+
+func defaultOptions() options {
+	return options{
+		SysdbaPassword: DefaultSysdbaPassword,
+		Database:       DefaultDatabase,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Firebird container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithSysdbaPassword sets the password of the SYSDBA account.
+func WithSysdbaPassword(password string) Option {
+	return func(o *options) {
+		o.SysdbaPassword = password
+	}
+}
+
+// WithDatabase sets the name of the database file created in the /firebird/data directory
+// when the container starts, e.g. "legacy.fdb".
+func WithDatabase(database string) Option {
+	return func(o *options) {
+		o.Database = database
+	}
+}
@@ -0,0 +1,37 @@
+package flink_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/flink"
+)
+
+func ExampleRunContainer() {
+	// runFlinkContainer {
+	ctx := context.Background()
+
+	flinkContainer, err := flink.RunContainer(ctx, testcontainers.WithImage("flink:1.18.1-java11"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := flinkContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := flinkContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,307 @@
+package flink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	restPort = "8081/tcp"
+
+	jobManagerAlias  = "jobmanager"
+	taskManagerAlias = "taskmanager"
+)
+
+// FlinkContainer represents the Flink container type used in the module. The embedded container
+// is the JobManager, and the TaskManager is registered with it in the network created by the module.
+type FlinkContainer struct {
+	testcontainers.Container
+	TaskManager testcontainers.Container
+	network     *testcontainers.DockerNetwork
+}
+
+// RunContainer creates an instance of the Flink container type, running a session cluster made of
+// a JobManager and a TaskManager attached to a network created by the module. The options are applied
+// to the JobManager, and the TaskManager uses the same image.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*FlinkContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "flink:1.18.1-java11",
+		ExposedPorts: []string{restPort},
+		Cmd:          []string{"jobmanager"},
+		Env:          map[string]string{},
+		WaitingFor:   wait.ForHTTP("/overview").WithPort(restPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	settings.Configuration["jobmanager.rpc.address"] = jobManagerAlias
+	flinkProperties := renderProperties(settings.Configuration)
+	genericContainerReq.Env["FLINK_PROPERTIES"] = flinkProperties
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	c := &FlinkContainer{network: nw}
+
+	network.WithNetwork([]string{jobManagerAlias}, nw)(&genericContainerReq)
+
+	jobManager, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+	c.Container = jobManager
+
+	taskManager, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image: genericContainerReq.Image,
+			Cmd:   []string{"taskmanager"},
+			Env: map[string]string{
+				"FLINK_PROPERTIES": flinkProperties,
+			},
+			Networks: []string{nw.Name},
+			NetworkAliases: map[string][]string{
+				nw.Name: {taskManagerAlias},
+			},
+		},
+		Started: true,
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start the TaskManager: %w", err)
+	}
+	c.TaskManager = taskManager
+
+	// the cluster is ready once the TaskManager is registered with the JobManager
+	err = wait.ForHTTP("/overview").
+		WithPort(restPort).
+		WithStartupTimeout(2*time.Minute).
+		WithResponseMatcher(func(body io.Reader) bool {
+			var overview struct {
+				TaskManagers int `json:"taskmanagers"`
+			}
+			if err := json.NewDecoder(body).Decode(&overview); err != nil {
+				return false
+			}
+			return overview.TaskManagers > 0
+		}).
+		WaitUntilReady(ctx, c.Container)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("the TaskManager is not registered: %w", err)
+	}
+
+	return c, nil
+}
+
+// renderProperties returns the content of the Flink configuration, with the properties sorted by key.
+func renderProperties(properties map[string]string) string {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k + ": " + properties[k] + "\n")
+	}
+
+	return sb.String()
+}
+
+// RESTURL returns the URL of the REST API of the JobManager, e.g. "http://localhost:32768",
+// which also serves the web UI.
+func (c *FlinkContainer) RESTURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// SubmitJar uploads the jar file in the given path of the host to the JobManager, and runs its
+// entry class with the given program arguments, returning the ID of the job. The entry class
+// is read from the manifest of the jar.
+func (c *FlinkContainer) SubmitJar(ctx context.Context, jarPath string, args ...string) (string, error) {
+	jarID, err := c.uploadJar(ctx, jarPath)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string][]string{"programArgsList": args})
+	if err != nil {
+		return "", err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/jars/"+jarID+"/run", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to run the jar: %w", err)
+	}
+
+	var run struct {
+		JobID string `json:"jobid"`
+	}
+	if err := json.Unmarshal(respBody, &run); err != nil {
+		return "", fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	return run.JobID, nil
+}
+
+// uploadJar uploads the jar file in the given path of the host, returning the ID of the jar in the JobManager.
+func (c *FlinkContainer) uploadJar(ctx context.Context, jarPath string) (string, error) {
+	f, err := os.Open(jarPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile("jarfile", filepath.Base(jarPath))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(part, f); err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/jars/upload", w.FormDataContentType(), &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload the jar: %w", err)
+	}
+
+	var upload struct {
+		Filename string `json:"filename"`
+		Status   string `json:"status"`
+	}
+	if err := json.Unmarshal(respBody, &upload); err != nil {
+		return "", fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	if upload.Status != "success" {
+		return "", fmt.Errorf("failed to upload the jar: status %s", upload.Status)
+	}
+
+	// the ID of the jar is the base name of the uploaded file in the JobManager
+	return path.Base(upload.Filename), nil
+}
+
+// JobState returns the state of the job with the given ID, e.g. "RUNNING", "FINISHED" or "FAILED".
+func (c *FlinkContainer) JobState(ctx context.Context, jobID string) (string, error) {
+	respBody, err := c.do(ctx, http.MethodGet, "/jobs/"+jobID, "", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var job struct {
+		State string `json:"state"`
+	}
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return "", fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	return job.State, nil
+}
+
+// CancelJob cancels the job with the given ID.
+func (c *FlinkContainer) CancelJob(ctx context.Context, jobID string) error {
+	_, err := c.do(ctx, http.MethodPatch, "/jobs/"+jobID+"?mode=cancel", "", nil)
+	return err
+}
+
+// Terminate terminates the TaskManager and the JobManager containers, and removes the network.
+func (c *FlinkContainer) Terminate(ctx context.Context) error {
+	if c.TaskManager != nil {
+		if err := c.TaskManager.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Container != nil {
+		if err := c.Container.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.network != nil {
+		return c.network.Remove(ctx)
+	}
+
+	return nil
+}
+
+// do sends a request to the REST API, returning the body of the response,
+// or an error if the status code is not 2xx.
+func (c *FlinkContainer) do(ctx context.Context, method string, path string, contentType string, body io.Reader) ([]byte, error) {
+	baseURL, err := c.RESTURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, string(respBody))
+	}
+
+	return respBody, nil
+}
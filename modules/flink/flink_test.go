@@ -0,0 +1,124 @@
+package flink_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/flink"
+)
+
+func TestFlink(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := flink.RunContainer(ctx, flink.WithTaskSlots(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// restURL {
+	restURL, err := container.RESTURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(restURL + "/overview")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var overview struct {
+		TaskManagers int `json:"taskmanagers"`
+		SlotsTotal   int `json:"slots-total"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&overview); err != nil {
+		t.Fatal(err)
+	}
+
+	if overview.TaskManagers != 1 {
+		t.Fatalf("expected 1 TaskManager, got %d", overview.TaskManagers)
+	}
+
+	if overview.SlotsTotal != 4 {
+		t.Fatalf("expected 4 task slots, got %d", overview.SlotsTotal)
+	}
+}
+
+func TestFlinkSubmitJar(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := flink.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// the examples bundled in the image, copied to the host to be uploaded
+	jarPath := filepath.Join(t.TempDir(), "WordCount.jar")
+	copyFromContainer(t, container, "/opt/flink/examples/streaming/WordCount.jar", jarPath)
+
+	// submitJar {
+	jobID, err := container.SubmitJar(ctx, jarPath, "--output", "/tmp/wordcount")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the job counts the words of a bundled text, so it finishes on its own
+	deadline := time.Now().Add(time.Minute)
+	for {
+		state, err := container.JobState(ctx, jobID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if state == "FINISHED" {
+			break
+		}
+
+		if state == "FAILED" || time.Now().After(deadline) {
+			t.Fatalf("expected the job to finish, got %s", state)
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+func copyFromContainer(t *testing.T, container *flink.FlinkContainer, containerPath string, hostPath string) {
+	t.Helper()
+
+	reader, err := container.CopyFileFromContainer(context.Background(), containerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	f, err := os.Create(hostPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		t.Fatal(err)
+	}
+}
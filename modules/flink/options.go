@@ -0,0 +1,51 @@
+package flink
+
+import (
+	"strconv"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Configuration is a map of Flink configuration properties, passed to both
+	// the JobManager and the TaskManager with the FLINK_PROPERTIES variable.
+	Configuration map[string]string
+}
+
+func defaultOptions() options {
+	return options{
+		Configuration: map[string]string{
+			"taskmanager.numberOfTaskSlots": "2",
+		},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Flink container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithConfiguration adds Flink configuration properties, e.g. "state.backend.type": "rocksdb",
+// to both the JobManager and the TaskManager. It can be called multiple times.
+// The "jobmanager.rpc.address" property is always set by the module.
+func WithConfiguration(properties map[string]string) Option {
+	return func(o *options) {
+		for k, v := range properties {
+			o.Configuration[k] = v
+		}
+	}
+}
+
+// WithTaskSlots sets the number of task slots of the TaskManager, which is 2 by default,
+// limiting the parallelism of the jobs.
+func WithTaskSlots(slots int) Option {
+	return func(o *options) {
+		o.Configuration["taskmanager.numberOfTaskSlots"] = strconv.Itoa(slots)
+	}
+}
@@ -0,0 +1,37 @@
+package ftp_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/ftp"
+)
+
+func ExampleRunContainer() {
+	// runFTPContainer {
+	ctx := context.Background()
+
+	ftpContainer, err := ftp.RunContainer(ctx, testcontainers.WithImage("stilliard/pure-ftpd:hardened"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := ftpContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := ftpContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
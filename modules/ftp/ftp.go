@@ -0,0 +1,171 @@
+package ftp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const controlPort = "21/tcp"
+
+const (
+	// DefaultUser is the default user of the FTP server.
+	DefaultUser = "testcontainers"
+	// DefaultPassword is the default password of the FTP server.
+	DefaultPassword = "testcontainers"
+)
+
+// FTPContainer represents the FTP container type used in the module
+type FTPContainer struct {
+	testcontainers.Container
+	user     string
+	password string
+}
+
+// RunContainer creates an instance of the FTP container type, running Pure-FTPd with a single user.
+//
+// In passive mode, the server tells the client the address and the port of the data connection,
+// which must be reachable from the client. So the passive ports are bound to the same ports of the
+// Docker host, picked among the free ones, and the server advertises the address of the Docker host.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*FTPContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "stilliard/pure-ftpd:hardened",
+		ExposedPorts: []string{controlPort},
+		WaitingFor:   wait.ForListeningPort(controlPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.PassivePorts < 1 {
+		return nil, errors.New("at least one passive port is required")
+	}
+
+	publicHost, err := dockerHostIP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	firstPort, err := freePortRange(settings.PassivePorts)
+	if err != nil {
+		return nil, err
+	}
+	lastPort := firstPort + settings.PassivePorts - 1
+
+	// the passive ports are bound to the same ports of the Docker host
+	genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, fmt.Sprintf("%d-%d:%d-%d/tcp", firstPort, lastPort, firstPort, lastPort))
+
+	if genericContainerReq.Env == nil {
+		genericContainerReq.Env = map[string]string{}
+	}
+	genericContainerReq.Env["PUBLICHOST"] = publicHost
+	genericContainerReq.Env["FTP_PASSIVE_PORTS"] = fmt.Sprintf("%d:%d", firstPort, lastPort)
+	genericContainerReq.Env["FTP_USER_NAME"] = settings.User
+	genericContainerReq.Env["FTP_USER_PASS"] = settings.Password
+	genericContainerReq.Env["FTP_USER_HOME"] = "/home/" + settings.User
+	// each passive port serves a single data connection at a time
+	genericContainerReq.Env["FTP_MAX_CLIENTS"] = strconv.Itoa(settings.PassivePorts)
+	genericContainerReq.Env["FTP_MAX_CONNECTIONS"] = strconv.Itoa(settings.PassivePorts)
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FTPContainer{Container: container, user: settings.User, password: settings.Password}, nil
+}
+
+// dockerHostIP returns the IP address of the Docker host, which is advertised by the server in passive mode.
+func dockerHostIP(ctx context.Context) (string, error) {
+	provider, err := testcontainers.NewDockerProvider()
+	if err != nil {
+		return "", err
+	}
+	defer provider.Close()
+
+	host, err := provider.DaemonHost(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip4", host)
+	if err != nil {
+		return "", fmt.Errorf("resolve the Docker host %s: %w", host, err)
+	}
+
+	return ips[0].String(), nil
+}
+
+// freePortRange returns the first port of a range of n consecutive ports which are free on this host,
+// which is the Docker host unless a remote Docker daemon is used.
+func freePortRange(n int) (int, error) {
+	const minPort, maxPort = 30000, 60000
+
+	for attempt := 0; attempt < 20; attempt++ {
+		first := minPort + rand.Intn(maxPort-minPort-n)
+		if portsFree(first, n) {
+			return first, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no range of %d free ports found", n)
+}
+
+func portsFree(first int, n int) bool {
+	for port := first; port < first+n; port++ {
+		l, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+		if err != nil {
+			return false
+		}
+		l.Close()
+	}
+
+	return true
+}
+
+// User returns the user of the FTP server.
+func (c *FTPContainer) User() string {
+	return c.user
+}
+
+// Password returns the password of the FTP server.
+func (c *FTPContainer) Password() string {
+	return c.password
+}
+
+// Address returns the "host:port" address of the control connection, to be used by the FTP clients,
+// in passive mode.
+func (c *FTPContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, controlPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
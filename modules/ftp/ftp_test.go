@@ -0,0 +1,166 @@
+package ftp_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/ftp"
+)
+
+func TestFTP(t *testing.T) {
+	ctx := context.Background()
+
+	// withUser {
+	container, err := ftp.RunContainer(ctx, ftp.WithUser("alice", "s3cr3t"), ftp.WithPassivePorts(5))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// address {
+	address, err := container.Address(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := login(t, address, container.User(), container.Password())
+	defer conn.Close()
+
+	// the data connections use the passive ports, advertised by the server
+	data := passive(t, conn)
+	cmd(t, conn, "STOR report.csv", 150)
+	if _, err := io.WriteString(data, "id,amount\n1,42\n"); err != nil {
+		t.Fatal(err)
+	}
+	data.Close()
+	read(t, conn, 226)
+
+	data = passive(t, conn)
+	cmd(t, conn, "RETR report.csv", 150)
+	content, err := io.ReadAll(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data.Close()
+	read(t, conn, 226)
+
+	if string(content) != "id,amount\n1,42\n" {
+		t.Fatalf("unexpected content %q", content)
+	}
+}
+
+func TestFTPWithWrongPassword(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := ftp.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	address, err := container.Address(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := textproto.Dial("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	read(t, conn, 220)
+	cmd(t, conn, "USER "+ftp.DefaultUser, 331)
+
+	if err := conn.PrintfLine("PASS wrong"); err != nil {
+		t.Fatal(err)
+	}
+
+	if code, _, err := conn.ReadResponse(230); err == nil {
+		t.Fatalf("expected the login to fail, got %d", code)
+	}
+}
+
+// login opens the control connection, authenticated as the given user, in binary mode.
+func login(t *testing.T, address string, user string, password string) *textproto.Conn {
+	t.Helper()
+
+	conn, err := textproto.Dial("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read(t, conn, 220)
+	cmd(t, conn, "USER "+user, 331)
+	cmd(t, conn, "PASS "+password, 230)
+	cmd(t, conn, "TYPE I", 200)
+
+	return conn
+}
+
+var pasvAddress = regexp.MustCompile(`\((\d+),(\d+),(\d+),(\d+),(\d+),(\d+)\)`)
+
+// passive enters the passive mode, returning the data connection to the advertised address.
+func passive(t *testing.T, conn *textproto.Conn) net.Conn {
+	t.Helper()
+
+	msg := cmd(t, conn, "PASV", 227)
+
+	m := pasvAddress.FindStringSubmatch(msg)
+	if m == nil {
+		t.Fatalf("unexpected passive mode response %s", msg)
+	}
+
+	p1, _ := strconv.Atoi(m[5])
+	p2, _ := strconv.Atoi(m[6])
+	address := net.JoinHostPort(strings.Join(m[1:5], "."), strconv.Itoa(p1*256+p2))
+
+	data, err := net.DialTimeout("tcp", address, 5*time.Second)
+	if err != nil {
+		t.Fatal(fmt.Errorf("the advertised passive address %s is not reachable: %w", address, err))
+	}
+
+	return data
+}
+
+func cmd(t *testing.T, conn *textproto.Conn, command string, expectedCode int) string {
+	t.Helper()
+
+	if err := conn.PrintfLine("%s", command); err != nil {
+		t.Fatal(err)
+	}
+
+	return read(t, conn, expectedCode)
+}
+
+func read(t *testing.T, conn *textproto.Conn, expectedCode int) string {
+	t.Helper()
+
+	_, msg, err := conn.ReadResponse(expectedCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return msg
+}
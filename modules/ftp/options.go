@@ -0,0 +1,49 @@
+package ftp
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// User is the user of the FTP server.
+	User string
+	// Password is the password of the FTP server.
+	Password string
+	// PassivePorts is the number of passive ports.
+	PassivePorts int
+}
+
+func defaultOptions() options {
+	return options{
+		User:         DefaultUser,
+		Password:     DefaultPassword,
+		PassivePorts: 10,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the FTP container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUser sets the user and the password of the FTP server.
+func WithUser(user string, password string) Option {
+	return func(o *options) {
+		o.User = user
+		o.Password = password
+	}
+}
+
+// WithPassivePorts sets the number of passive ports, 10 by default, which is the maximum number of
+// concurrent connections to the server.
+func WithPassivePorts(n int) Option {
+	return func(o *options) {
+		o.PassivePorts = n
+	}
+}
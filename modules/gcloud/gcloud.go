@@ -11,10 +11,15 @@ import (
 
 const defaultProjectID = "test-project"
 
+// GCloudContainer represents the GCloud emulator container type used in the module,
+// shared by all the emulators.
 type GCloudContainer struct {
 	testcontainers.Container
+	// Settings are the options used to start the emulator, e.g. the project ID.
 	Settings options
-	URI      string
+	// URI is the address of the emulator, in the format host:port, ready to be used with the
+	// option.WithEndpoint option of the Google client libraries, or as the target of grpc.Dial.
+	URI string
 }
 
 // newGCloudContainer creates a new GCloud container, obtaining the URL to access the container from the specified port.
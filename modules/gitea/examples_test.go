@@ -0,0 +1,37 @@
+package gitea_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/gitea"
+)
+
+func ExampleRunContainer() {
+	// runGiteaContainer {
+	ctx := context.Background()
+
+	giteaContainer, err := gitea.RunContainer(ctx, testcontainers.WithImage("gitea/gitea:1.21.4"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := giteaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := giteaContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
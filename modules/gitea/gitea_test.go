@@ -0,0 +1,143 @@
+package gitea_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/gitea"
+)
+
+func TestGitea(t *testing.T) {
+	ctx := context.Background()
+
+	// withAdminCredentials {
+	container, err := gitea.RunContainer(ctx, gitea.WithAdminCredentials("ci-bot", "s3cr3t-password"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// createRepository {
+	err = container.CreateRepository(ctx, "app", true)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// cloneURL {
+	cloneURL, err := container.CloneURL(ctx, "app")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(cloneURL, "ci-bot:s3cr3t-password@") || !strings.HasSuffix(cloneURL, "/ci-bot/app.git") {
+		t.Fatalf("unexpected clone URL %s", cloneURL)
+	}
+
+	// the Git client starts a clone by discovering the references with the smart HTTP protocol
+	resp, err := http.Get(cloneURL + "/info/refs?service=git-upload-pack")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	refs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(refs), "refs/heads/main") {
+		t.Fatalf("expected the main branch, got status code %d: %s", resp.StatusCode, refs)
+	}
+
+	if err := container.CreateRepository(ctx, "app", false); err == nil {
+		t.Fatal("expected an error when creating an existing repository")
+	}
+}
+
+func TestGiteaSSH(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := gitea.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// addSSHKey {
+	err = container.AddSSHKey(ctx, "ci", authorizedKey(publicKey))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sshEndpoint {
+	endpoint, err := container.SSHEndpoint(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.DialTimeout("tcp", endpoint, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	banner, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(banner, "SSH-2.0-") {
+		t.Fatalf("expected an SSH banner, got %s", banner)
+	}
+
+	sshCloneURL, err := container.SSHCloneURL(ctx, "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sshCloneURL != "ssh://git@"+endpoint+"/"+gitea.DefaultAdminUser+"/app.git" {
+		t.Fatalf("unexpected SSH clone URL %s", sshCloneURL)
+	}
+}
+
+// authorizedKey returns the public key in the authorized_keys format, without depending on an SSH library.
+func authorizedKey(publicKey ed25519.PublicKey) string {
+	var wire []byte
+	for _, field := range [][]byte{[]byte("ssh-ed25519"), publicKey} {
+		wire = binary.BigEndian.AppendUint32(wire, uint32(len(field)))
+		wire = append(wire, field...)
+	}
+
+	return "ssh-ed25519 " + base64.StdEncoding.EncodeToString(wire)
+}
@@ -0,0 +1,39 @@
+package gitea
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// AdminUser is the user of the admin account.
+	AdminUser string
+	// AdminPassword is the password of the admin account.
+	AdminPassword string
+}
+
+func defaultOptions() options {
+	return options{
+		AdminUser:     DefaultAdminUser,
+		AdminPassword: DefaultAdminPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Gitea container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithAdminCredentials sets the user and the password of the admin account, created when the container starts.
+// The "admin" user name is reserved by Gitea.
+func WithAdminCredentials(user string, password string) Option {
+	return func(o *options) {
+		o.AdminUser = user
+		o.AdminPassword = password
+	}
+}
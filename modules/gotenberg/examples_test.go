@@ -0,0 +1,37 @@
+package gotenberg_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/gotenberg"
+)
+
+func ExampleRunContainer() {
+	// runGotenbergContainer {
+	ctx := context.Background()
+
+	gotenbergContainer, err := gotenberg.RunContainer(ctx, testcontainers.WithImage("gotenberg/gotenberg:8.0.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := gotenbergContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := gotenbergContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
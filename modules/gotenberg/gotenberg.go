@@ -0,0 +1,139 @@
+package gotenberg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "3000/tcp"
+
+// GotenbergContainer represents the Gotenberg container type used in the module
+type GotenbergContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Gotenberg container type, exposing the HTTP API converting
+// HTML, Markdown, URLs and office documents to PDF, with Chromium and LibreOffice. The container is
+// ready once the health check reports both of them up.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*GotenbergContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "gotenberg/gotenberg:8.0.0",
+		ExposedPorts: []string{httpPort},
+		WaitingFor: wait.ForHTTP("/health").
+			WithPort(httpPort).
+			WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GotenbergContainer{Container: container}, nil
+}
+
+// URL returns the base URL of the HTTP API, e.g. "http://localhost:32768", serving the conversion
+// routes under /forms, to be used by the application under test.
+func (c *GotenbergContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, port.Port())), nil
+}
+
+// ConvertHTML converts the given HTML document to PDF with Chromium, returning the PDF.
+func (c *GotenbergContainer) ConvertHTML(ctx context.Context, html io.Reader) ([]byte, error) {
+	return c.convert(ctx, "/forms/chromium/convert/html", nil, map[string]io.Reader{"index.html": html})
+}
+
+// ConvertURL converts the page at the given URL to PDF with Chromium, returning the PDF. The URL must be
+// reachable from the container, e.g. with the network alias of a container attached to the same network.
+func (c *GotenbergContainer) ConvertURL(ctx context.Context, url string) ([]byte, error) {
+	return c.convert(ctx, "/forms/chromium/convert/url", map[string]string{"url": url}, nil)
+}
+
+// ConvertOffice converts the given office document to PDF with LibreOffice, returning the PDF.
+// The extension of the file name, e.g. "report.docx", sets the format of the document.
+func (c *GotenbergContainer) ConvertOffice(ctx context.Context, filename string, document io.Reader) ([]byte, error) {
+	return c.convert(ctx, "/forms/libreoffice/convert", nil, map[string]io.Reader{filename: document})
+}
+
+// convert posts the given fields and files to the given route, returning the PDF.
+func (c *GotenbergContainer) convert(ctx context.Context, route string, fields map[string]string, files map[string]io.Reader) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	for name, value := range fields {
+		if err := w.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for filename, r := range files {
+		part, err := w.CreateFormFile("files", filename)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.Copy(part, r); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+route, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, route, respBody)
+	}
+
+	return respBody, nil
+}
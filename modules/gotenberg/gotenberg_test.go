@@ -0,0 +1,82 @@
+package gotenberg_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/gotenberg"
+)
+
+// pdfHeader is the header of the PDF files.
+var pdfHeader = []byte("%PDF-")
+
+func TestGotenberg(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := gotenberg.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("html", func(t *testing.T) {
+		// convertHTML {
+		pdf, err := container.ConvertHTML(ctx, strings.NewReader("<html><body><h1>Hello world</h1></body></html>"))
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.HasPrefix(pdf, pdfHeader) {
+			t.Fatal("expected a PDF")
+		}
+	})
+
+	t.Run("office", func(t *testing.T) {
+		f, err := os.Open("testdata/inventory.csv")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		// convertOffice {
+		pdf, err := container.ConvertOffice(ctx, "inventory.csv", f)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.HasPrefix(pdf, pdfHeader) {
+			t.Fatal("expected a PDF")
+		}
+	})
+
+	t.Run("url", func(t *testing.T) {
+		// Gotenberg serves its health check, so it can convert its own page
+		// convertURL {
+		pdf, err := container.ConvertURL(ctx, "http://localhost:3000/health")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.HasPrefix(pdf, pdfHeader) {
+			t.Fatal("expected a PDF")
+		}
+	})
+
+	t.Run("unsupported-format", func(t *testing.T) {
+		if _, err := container.ConvertOffice(ctx, "inventory.unknown", strings.NewReader("test")); err == nil {
+			t.Fatal("expected an error for an unsupported format")
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package grafanalgtm_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	grafanalgtm "github.com/testcontainers/testcontainers-go/modules/grafana-lgtm"
+)
+
+func ExampleRunContainer() {
+	// runGrafanaLGTMContainer {
+	ctx := context.Background()
+
+	lgtmContainer, err := grafanalgtm.RunContainer(ctx, testcontainers.WithImage("grafana/otel-lgtm:0.6.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := lgtmContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := lgtmContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,145 @@
+package grafanalgtm
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	grafanaPort    = "3000/tcp"
+	lokiPort       = "3100/tcp"
+	tempoPort      = "3200/tcp"
+	otlpGRPCPort   = "4317/tcp"
+	otlpHTTPPort   = "4318/tcp"
+	prometheusPort = "9090/tcp"
+)
+
+const (
+	// DefaultAdminUser is the default user of the Grafana UI and API.
+	DefaultAdminUser = "admin"
+	// DefaultAdminPassword is the default password of the Grafana UI and API.
+	DefaultAdminPassword = "admin"
+)
+
+// GrafanaLGTMContainer represents the Grafana LGTM container type used in the module
+type GrafanaLGTMContainer struct {
+	testcontainers.Container
+	adminUser     string
+	adminPassword string
+}
+
+// RunContainer creates an instance of the Grafana LGTM container type, running the OpenTelemetry Collector,
+// Prometheus, Loki, Tempo and Grafana in a single container. The collector receives the OTLP signals and
+// sends the metrics to Prometheus, the logs to Loki and the traces to Tempo, which are Grafana data sources.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*GrafanaLGTMContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "grafana/otel-lgtm:0.6.0",
+		ExposedPorts: []string{grafanaPort, lokiPort, tempoPort, otlpGRPCPort, otlpHTTPPort, prometheusPort},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("The OpenTelemetry collector and the Grafana LGTM stack are up and running"),
+			wait.ForListeningPort(otlpGRPCPort),
+			wait.ForListeningPort(otlpHTTPPort),
+		).WithDeadline(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env == nil {
+		genericContainerReq.Env = map[string]string{}
+	}
+	genericContainerReq.Env["GF_SECURITY_ADMIN_USER"] = settings.AdminUser
+	genericContainerReq.Env["GF_SECURITY_ADMIN_PASSWORD"] = settings.AdminPassword
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrafanaLGTMContainer{
+		Container:     container,
+		adminUser:     settings.AdminUser,
+		adminPassword: settings.AdminPassword,
+	}, nil
+}
+
+// OTLPGRPCEndpoint returns the "host:port" endpoint of the OTLP gRPC receiver, to be used by the
+// OpenTelemetry exporters, e.g. with otlptracegrpc.WithEndpoint and otlptracegrpc.WithInsecure.
+func (c *GrafanaLGTMContainer) OTLPGRPCEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpGRPCPort)
+}
+
+// OTLPHTTPEndpoint returns the "host:port" endpoint of the OTLP HTTP receiver, to be used by the
+// OpenTelemetry exporters, e.g. with otlptracehttp.WithEndpoint and otlptracehttp.WithInsecure.
+func (c *GrafanaLGTMContainer) OTLPHTTPEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpHTTPPort)
+}
+
+// PrometheusURL returns the base URL of Prometheus, e.g. "http://localhost:32768", to query the metrics.
+func (c *GrafanaLGTMContainer) PrometheusURL(ctx context.Context) (string, error) {
+	return c.url(ctx, prometheusPort)
+}
+
+// LokiURL returns the base URL of Loki, e.g. "http://localhost:32768", to query the logs.
+func (c *GrafanaLGTMContainer) LokiURL(ctx context.Context) (string, error) {
+	return c.url(ctx, lokiPort)
+}
+
+// TempoURL returns the base URL of Tempo, e.g. "http://localhost:32768", to query the traces.
+func (c *GrafanaLGTMContainer) TempoURL(ctx context.Context) (string, error) {
+	return c.url(ctx, tempoPort)
+}
+
+// GrafanaURL returns the base URL of the Grafana UI and API, e.g. "http://localhost:32768".
+func (c *GrafanaLGTMContainer) GrafanaURL(ctx context.Context) (string, error) {
+	return c.url(ctx, grafanaPort)
+}
+
+// AdminUser returns the user of the Grafana UI and API.
+func (c *GrafanaLGTMContainer) AdminUser() string {
+	return c.adminUser
+}
+
+// AdminPassword returns the password of the Grafana UI and API.
+func (c *GrafanaLGTMContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+func (c *GrafanaLGTMContainer) url(ctx context.Context, port nat.Port) (string, error) {
+	endpoint, err := c.endpoint(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + endpoint, nil
+}
+
+func (c *GrafanaLGTMContainer) endpoint(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
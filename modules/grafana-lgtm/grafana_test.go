@@ -0,0 +1,170 @@
+package grafanalgtm_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	grafanalgtm "github.com/testcontainers/testcontainers-go/modules/grafana-lgtm"
+)
+
+// otlpGauge is a gauge in the OTLP JSON encoding, sent as is to the OTLP HTTP receiver
+// to avoid depending on the OpenTelemetry SDK.
+const otlpGauge = `{
+  "resourceMetrics": [{
+    "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "testcontainers"}}]},
+    "scopeMetrics": [{
+      "metrics": [{
+        "name": "test_requests",
+        "gauge": {"dataPoints": [{"asInt": "42", "timeUnixNano": "%d"}]}
+      }]
+    }]
+  }]
+}`
+
+func TestGrafanaLGTM(t *testing.T) {
+	ctx := context.Background()
+
+	// withAdminCredentials {
+	container, err := grafanalgtm.RunContainer(ctx, grafanalgtm.WithAdminCredentials("admin", "123456789"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		// otlpHTTPEndpoint {
+		otlpEndpoint, err := container.OTLPHTTPEndpoint(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body := fmt.Sprintf(otlpGauge, time.Now().UnixNano())
+		resp, err := http.Post("http://"+otlpEndpoint+"/v1/metrics", "application/json", bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		// prometheusURL {
+		prometheusURL, err := container.PrometheusURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the collector sends the metrics to Prometheus asynchronously
+		deadline := time.Now().Add(time.Minute)
+		for {
+			value, err := queryValue(prometheusURL, "test_requests")
+			if err == nil && value == "42" {
+				break
+			}
+
+			if time.Now().After(deadline) {
+				t.Fatalf("expected the gauge to be stored, got %q: %v", value, err)
+			}
+
+			time.Sleep(time.Second)
+		}
+	})
+
+	t.Run("grafana", func(t *testing.T) {
+		// grafanaURL {
+		grafanaURL, err := container.GrafanaURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, grafanaURL+"/api/datasources", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(container.AdminUser(), container.AdminPassword())
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		var datasources []struct {
+			Type string `json:"type"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&datasources); err != nil {
+			t.Fatal(err)
+		}
+
+		types := map[string]bool{}
+		for _, ds := range datasources {
+			types[ds.Type] = true
+		}
+
+		for _, expected := range []string{"prometheus", "loki", "tempo"} {
+			if !types[expected] {
+				t.Fatalf("expected a %s data source, got %v", expected, datasources)
+			}
+		}
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		for name, endpoint := range map[string]func(context.Context) (string, error){
+			"otlp grpc": container.OTLPGRPCEndpoint,
+			"loki":      container.LokiURL,
+			"tempo":     container.TempoURL,
+		} {
+			if _, err := endpoint(ctx); err != nil {
+				t.Fatalf("failed to get the %s endpoint: %s", name, err)
+			}
+		}
+	})
+}
+
+// queryValue runs an instant query, returning the value of the first sample.
+func queryValue(prometheusURL string, query string) (string, error) {
+	resp, err := http.Get(prometheusURL + "/api/v1/query?query=" + url.QueryEscape(query))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			Result []struct {
+				Value []interface{} `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	if len(result.Data.Result) == 0 || len(result.Data.Result[0].Value) != 2 {
+		return "", nil
+	}
+
+	value, _ := result.Data.Result[0].Value[1].(string)
+	return value, nil
+}
@@ -0,0 +1,189 @@
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// The roles of the members of a project.
+const (
+	RoleProjectAdmin = 1
+	RoleDeveloper    = 2
+	RoleGuest        = 3
+	RoleMaintainer   = 4
+	RoleLimitedGuest = 5
+)
+
+// Project is a project of Harbor, the namespace of the repositories, e.g. "library" for "library/alpine".
+type Project struct {
+	// Name is the name of the project.
+	Name string
+	// Public makes the repositories of the project pullable without authentication.
+	Public bool
+	// StorageLimit is the storage quota of the project, in bytes. Defaults to unlimited.
+	StorageLimit int64
+}
+
+// User is a local user of Harbor.
+type User struct {
+	// Username is the name of the user, used to log in.
+	Username string `json:"username"`
+	// Password is the password of the user, at least 8 characters long, with at least one uppercase letter,
+	// one lowercase letter and one digit.
+	Password string `json:"password"`
+	// Email is the email of the user, which must be unique.
+	Email string `json:"email"`
+	// RealName is the display name of the user.
+	RealName string `json:"realname"`
+}
+
+// RobotAccount is a robot account of a project, to be used by the clients of the registry, e.g. in CI.
+type RobotAccount struct {
+	// Name is the full name of the robot account, e.g. "robot$library+ci", used as the user name.
+	Name string `json:"name"`
+	// Secret is the secret of the robot account, used as the password.
+	Secret string `json:"secret"`
+}
+
+// Quota is the storage quota of a project.
+type Quota struct {
+	// Hard is the storage limit of the project, in bytes, or -1 if unlimited.
+	Hard int64
+	// Used is the storage used by the project, in bytes.
+	Used int64
+}
+
+// CreateProject creates the given project.
+func (c *HarborContainer) CreateProject(ctx context.Context, project Project) error {
+	body := map[string]interface{}{
+		"project_name": project.Name,
+		"metadata": map[string]string{
+			"public": strconv.FormatBool(project.Public),
+		},
+	}
+	if project.StorageLimit != 0 {
+		body["storage_limit"] = project.StorageLimit
+	}
+
+	_, err := c.do(ctx, http.MethodPost, "/projects", body, http.StatusCreated)
+	return err
+}
+
+// CreateUser creates the given local user.
+func (c *HarborContainer) CreateUser(ctx context.Context, user User) error {
+	_, err := c.do(ctx, http.MethodPost, "/users", user, http.StatusCreated)
+	return err
+}
+
+// AddProjectMember adds the user with the given name to the project, with the given role, e.g. RoleDeveloper.
+func (c *HarborContainer) AddProjectMember(ctx context.Context, project string, username string, role int) error {
+	body := map[string]interface{}{
+		"role_id": role,
+		"member_user": map[string]string{
+			"username": username,
+		},
+	}
+
+	_, err := c.do(ctx, http.MethodPost, "/projects/"+url.PathEscape(project)+"/members", body, http.StatusCreated)
+	return err
+}
+
+// CreateRobotAccount creates a robot account with the given name in the project, allowed to pull and push
+// the repositories of the project, and never expiring. The secret of the robot account is only returned once.
+func (c *HarborContainer) CreateRobotAccount(ctx context.Context, project string, name string) (RobotAccount, error) {
+	body := map[string]interface{}{
+		"name":     name,
+		"level":    "project",
+		"duration": -1,
+		"permissions": []map[string]interface{}{
+			{
+				"kind":      "project",
+				"namespace": project,
+				"access": []map[string]string{
+					{"resource": "repository", "action": "pull"},
+					{"resource": "repository", "action": "push"},
+				},
+			},
+		},
+	}
+
+	var robot RobotAccount
+	respBody, err := c.do(ctx, http.MethodPost, "/robots", body, http.StatusCreated)
+	if err != nil {
+		return robot, err
+	}
+
+	err = json.Unmarshal(respBody, &robot)
+	return robot, err
+}
+
+// ProjectQuota returns the storage quota of the project.
+func (c *HarborContainer) ProjectQuota(ctx context.Context, project string) (Quota, error) {
+	respBody, err := c.do(ctx, http.MethodGet, "/projects/"+url.PathEscape(project)+"/summary", nil, http.StatusOK)
+	if err != nil {
+		return Quota{}, err
+	}
+
+	var summary struct {
+		Quota struct {
+			Hard struct {
+				Storage int64 `json:"storage"`
+			} `json:"hard"`
+			Used struct {
+				Storage int64 `json:"storage"`
+			} `json:"used"`
+		} `json:"quota"`
+	}
+	if err := json.Unmarshal(respBody, &summary); err != nil {
+		return Quota{}, err
+	}
+
+	return Quota{Hard: summary.Quota.Hard.Storage, Used: summary.Quota.Used.Storage}, nil
+}
+
+// do sends a request to the API, authenticated with the admin account.
+func (c *HarborContainer) do(ctx context.Context, method string, path string, body interface{}, expectedStatus int) ([]byte, error) {
+	apiURL, err := c.APIURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody []byte
+	if body != nil {
+		if reqBody, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, apiURL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// the projects are referenced by name
+	req.Header.Set("X-Is-Resource-Name", "true")
+	req.SetBasicAuth(AdminUser, c.adminPassword)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
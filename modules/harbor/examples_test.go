@@ -0,0 +1,37 @@
+package harbor_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/harbor"
+)
+
+func ExampleRunContainer() {
+	// runHarborContainer {
+	ctx := context.Background()
+
+	harborContainer, err := harbor.RunContainer(ctx, testcontainers.WithImage("goharbor/harbor-core:v2.10.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := harborContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := harborContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,362 @@
+package harbor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	corePort = "8080/tcp"
+
+	postgresAlias = "postgresql"
+	redisAlias    = "redis"
+	registryAlias = "registry"
+	coreAlias     = "core"
+
+	// the secrets shared by the components, which are not reachable from the host
+	postgresPassword = "testcontainers"
+	coreSecret       = "testcontainers-core-secret"
+	jobserviceSecret = "testcontainers-jobservice-secret"
+	registrySecret   = "testcontainers-registry-secret"
+	registryUser     = "harbor_registry_user"
+	registryPassword = "testcontainers-registry-password"
+	csrfKey          = "testcontainers-csrf-key-32-chars"
+	secretKey        = "testcontainers16"
+
+	registryConfigPath = "/etc/registry/config.yml"
+	registryPasswdPath = "/etc/registry/passwd"
+
+	coreConfigPath     = "/etc/core/app.conf"
+	coreKeyPath        = "/etc/core/key"
+	corePrivateKeyPath = "/etc/core/private_key.pem"
+
+	// externalEndpointPath is the file read by the entrypoint of the core before starting it, containing
+	// the URL of the core used by the clients running in the host, which is the realm of the token service.
+	externalEndpointPath = "/tmp/testcontainers-external-endpoint"
+)
+
+const (
+	// AdminUser is the user name of the admin account.
+	AdminUser = "admin"
+	// DefaultAdminPassword is the default initial password of the admin account.
+	DefaultAdminPassword = "Harbor12345"
+)
+
+// registryConfig is the configuration of the registry, which authenticates the core with htpasswd,
+// as the clients are authenticated by the core, proxying the requests to the registry.
+const registryConfig = `version: 0.1
+log:
+  level: info
+storage:
+  filesystem:
+    rootdirectory: /storage
+  delete:
+    enabled: true
+  redirect:
+    disable: true
+http:
+  addr: :5000
+  secret: ` + registrySecret + `
+auth:
+  htpasswd:
+    realm: harbor-registry-basic-realm
+    path: ` + registryPasswdPath + `
+validation:
+  disabled: true
+`
+
+const coreConfig = `appname = Harbor
+runmode = prod
+enablegzip = true
+
+[prod]
+httpport = 8080
+`
+
+// HarborContainer represents the Harbor container type used in the module. The embedded container
+// is the core, serving the API and proxying the registry, and the database, Redis and the registry
+// are attached to the same network, created by the module.
+type HarborContainer struct {
+	testcontainers.Container
+	Postgres      testcontainers.Container
+	Redis         testcontainers.Container
+	Registry      testcontainers.Container
+	network       *testcontainers.DockerNetwork
+	adminPassword string
+}
+
+// RunContainer creates an instance of the Harbor container type, running the core, the registry, the
+// database and Redis of Harbor, attached to a network created by the module, without the portal, the job
+// service and the scanners. The options are applied to the core, and the other components use the images
+// of the same version, e.g. goharbor/harbor-db:v2.10.0 for goharbor/harbor-core:v2.10.0. As the realm of the
+// token service must be the URL used by the clients running in the host, the core is started once its port
+// is mapped, and the container is ready once the API is available.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*HarborContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "goharbor/harbor-core:v2.10.0",
+		ExposedPorts: []string{corePort},
+		Env: map[string]string{
+			"CONFIG_PATH":                    coreConfigPath,
+			"CORE_SECRET":                    coreSecret,
+			"JOBSERVICE_SECRET":              jobserviceSecret,
+			"CSRF_KEY":                       csrfKey,
+			"DATABASE_TYPE":                  "postgresql",
+			"POSTGRESQL_HOST":                postgresAlias,
+			"POSTGRESQL_PORT":                "5432",
+			"POSTGRESQL_USERNAME":            "postgres",
+			"POSTGRESQL_PASSWORD":            postgresPassword,
+			"POSTGRESQL_DATABASE":            "registry",
+			"POSTGRESQL_SSLMODE":             "disable",
+			"_REDIS_URL_CORE":                "redis://" + redisAlias + ":6379?idle_timeout_seconds=30",
+			"_REDIS_URL_REG":                 "redis://" + redisAlias + ":6379/1?idle_timeout_seconds=30",
+			"REGISTRY_URL":                   "http://" + registryAlias + ":5000",
+			"REGISTRY_CREDENTIAL_USERNAME":   registryUser,
+			"REGISTRY_CREDENTIAL_PASSWORD":   registryPassword,
+			"REGISTRY_STORAGE_PROVIDER_NAME": "filesystem",
+			"REGISTRY_CONTROLLER_URL":        "http://registryctl:8080",
+			"TOKEN_SERVICE_URL":              "http://" + coreAlias + ":8080/service/token",
+			"CORE_URL":                       "http://" + coreAlias + ":8080",
+			"CORE_LOCAL_URL":                 "http://127.0.0.1:8080",
+			"JOBSERVICE_URL":                 "http://jobservice:8080",
+			"PORTAL_URL":                     "http://portal:8080",
+			"WITH_TRIVY":                     "false",
+			"SYNC_QUOTA":                     "true",
+			"QUOTA_UPDATE_PROVIDER":          "db",
+			"PORT":                           "8080",
+			"LOG_LEVEL":                      "info",
+		},
+		// wait for the external endpoint, which is copied after the container is started
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd: []string{
+			fmt.Sprintf("while [ ! -f %[1]s ]; do sleep 0.1; done; export EXT_ENDPOINT=$(cat %[1]s); exec /harbor/entrypoint.sh", externalEndpointPath),
+		},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	genericContainerReq.Env["HARBOR_ADMIN_PASSWORD"] = settings.AdminPassword
+
+	privateKey, err := privateKeyPEM()
+	if err != nil {
+		return nil, err
+	}
+
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostCreates: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				if err := c.CopyToContainer(ctx, []byte(coreConfig), coreConfigPath, 0o644); err != nil {
+					return fmt.Errorf("failed to copy the config into the container: %w", err)
+				}
+
+				if err := c.CopyToContainer(ctx, []byte(secretKey), coreKeyPath, 0o644); err != nil {
+					return fmt.Errorf("failed to copy the secret key into the container: %w", err)
+				}
+
+				if err := c.CopyToContainer(ctx, privateKey, corePrivateKeyPath, 0o644); err != nil {
+					return fmt.Errorf("failed to copy the private key into the container: %w", err)
+				}
+
+				return nil
+			},
+		},
+	})
+
+	// the wait strategy can only be checked once the external endpoint is copied
+	waitStrategy := genericContainerReq.WaitingFor
+	if waitStrategy == nil {
+		waitStrategy = wait.ForHTTP("/api/v2.0/ping").WithPort(corePort).WithStartupTimeout(3 * time.Minute)
+	}
+	genericContainerReq.WaitingFor = nil
+
+	passwd, err := bcrypt.GenerateFromPassword([]byte(registryPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	c := &HarborContainer{network: nw, adminPassword: settings.AdminPassword}
+
+	c.Postgres, err = c.runComponent(ctx, componentImage(genericContainerReq.Image, "harbor-db"), postgresAlias, testcontainers.ContainerRequest{
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": postgresPassword,
+		},
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithStartupTimeout(time.Minute),
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start the database: %w", err)
+	}
+
+	c.Redis, err = c.runComponent(ctx, componentImage(genericContainerReq.Image, "redis-photon"), redisAlias, testcontainers.ContainerRequest{
+		WaitingFor: wait.ForLog("Ready to accept connections"),
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start redis: %w", err)
+	}
+
+	c.Registry, err = c.runComponent(ctx, componentImage(genericContainerReq.Image, "registry-photon"), registryAlias, testcontainers.ContainerRequest{
+		LifecycleHooks: []testcontainers.ContainerLifecycleHooks{
+			{
+				PostCreates: []testcontainers.ContainerHook{
+					func(ctx context.Context, c testcontainers.Container) error {
+						if err := c.CopyToContainer(ctx, []byte(registryConfig), registryConfigPath, 0o644); err != nil {
+							return fmt.Errorf("failed to copy the config into the container: %w", err)
+						}
+
+						return c.CopyToContainer(ctx, []byte(registryUser+":"+string(passwd)+"\n"), registryPasswdPath, 0o644)
+					},
+				},
+			},
+		},
+		WaitingFor: wait.ForLog("listening on"),
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start the registry: %w", err)
+	}
+
+	network.WithNetwork([]string{coreAlias}, nw)(&genericContainerReq)
+
+	core, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+	c.Container = core
+
+	externalEndpoint, err := c.URL(ctx)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+
+	if err := core.CopyToContainer(ctx, []byte(externalEndpoint), externalEndpointPath, 0o644); err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to copy the external endpoint into the container: %w", err)
+	}
+
+	if err := waitStrategy.WaitUntilReady(ctx, core); err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to wait for Harbor readiness: %w", err)
+	}
+
+	return c, nil
+}
+
+// runComponent runs a component of Harbor, attached to the network with the given alias.
+func (c *HarborContainer) runComponent(ctx context.Context, image string, alias string, req testcontainers.ContainerRequest) (testcontainers.Container, error) {
+	req.Image = image
+	req.Networks = []string{c.network.Name}
+	req.NetworkAliases = map[string][]string{
+		c.network.Name: {alias},
+	}
+
+	return testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+}
+
+// componentImage returns the image of the given component, with the same repository and version as the core image.
+func componentImage(coreImage string, component string) string {
+	return strings.Replace(coreImage, "harbor-core", component, 1)
+}
+
+// privateKeyPEM generates the PEM encoded private key signing the tokens of the token service.
+func privateKeyPEM() ([]byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the private key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), nil
+}
+
+// Terminate terminates the core, the registry, Redis and the database containers, and removes the network.
+func (c *HarborContainer) Terminate(ctx context.Context) error {
+	for _, container := range []testcontainers.Container{c.Container, c.Registry, c.Redis, c.Postgres} {
+		if container != nil {
+			if err := container.Terminate(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.network != nil {
+		return c.network.Remove(ctx)
+	}
+
+	return nil
+}
+
+// AdminPassword returns the password of the admin account.
+func (c *HarborContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// RegistryAddress returns the "host:port" address of the registry, e.g. "localhost:32768", to be used
+// in the image references, e.g. "localhost:32768/library/alpine:3.19". The registry is served over HTTP,
+// which the Docker daemon allows for the localhost addresses.
+func (c *HarborContainer) RegistryAddress(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, corePort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// URL returns the base URL of Harbor, e.g. "http://localhost:32768", serving the API under /api/v2.0
+// and the registry under /v2.
+func (c *HarborContainer) URL(ctx context.Context) (string, error) {
+	address, err := c.RegistryAddress(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// APIURL returns the base URL of the API, e.g. "http://localhost:32768/api/v2.0".
+func (c *HarborContainer) APIURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v2.0", nil
+}
@@ -0,0 +1,163 @@
+package harbor_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/harbor"
+)
+
+func TestHarbor(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := harbor.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// createProject {
+	err = container.CreateProject(ctx, harbor.Project{
+		Name:         "testcontainers",
+		StorageLimit: 10 * 1024 * 1024,
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("quota", func(t *testing.T) {
+		// projectQuota {
+		quota, err := container.ProjectQuota(ctx, "testcontainers")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if quota.Hard != 10*1024*1024 || quota.Used != 0 {
+			t.Fatalf("expected an empty quota of 10 MiB, got %+v", quota)
+		}
+	})
+
+	t.Run("user", func(t *testing.T) {
+		// createUser {
+		err := container.CreateUser(ctx, harbor.User{
+			Username: "alice",
+			Password: "Alice12345",
+			Email:    "alice@example.com",
+			RealName: "Alice",
+		})
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// addProjectMember {
+		err = container.AddProjectMember(ctx, "testcontainers", "alice", harbor.RoleDeveloper)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/api/v2.0/projects/testcontainers/members", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-Is-Resource-Name", "true")
+		req.SetBasicAuth("alice", "Alice12345")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected the member to list the members, got status code %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("robot-account", func(t *testing.T) {
+		// createRobotAccount {
+		robot, err := container.CreateRobotAccount(ctx, "testcontainers", "ci")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if robot.Name != "robot$testcontainers+ci" || robot.Secret == "" {
+			t.Fatalf("expected the name and secret of the robot account, got %+v", robot)
+		}
+
+		// the robot account gets a token of the registry, as the clients do when pushing an image
+		query := url.Values{
+			"service": {"harbor-registry"},
+			"scope":   {"repository:testcontainers/app:pull,push"},
+		}
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/service/token?"+query.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(robot.Name, robot.Secret)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		var result struct {
+			Token string `json:"token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Token == "" {
+			t.Fatal("expected a token")
+		}
+	})
+
+	t.Run("registry-address", func(t *testing.T) {
+		// registryAddress {
+		address, err := container.RegistryAddress(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the registry challenges the anonymous clients with the token service
+		resp, err := http.Get("http://" + address + "/v2/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("expected status code %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+
+		expected := `Bearer realm="` + baseURL + `/service/token",service="harbor-registry"`
+		if challenge := resp.Header.Get("Www-Authenticate"); challenge != expected {
+			t.Fatalf("expected the challenge %s, got %s", expected, challenge)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package harbor
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// AdminPassword is the initial password of the admin account.
+	AdminPassword string
+}
+
+func defaultOptions() options {
+	return options{
+		AdminPassword: DefaultAdminPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Harbor container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithAdminPassword sets the initial password of the admin account, which must be at least 8 characters
+// long, with at least one uppercase letter, one lowercase letter and one digit.
+func WithAdminPassword(password string) Option {
+	return func(o *options) {
+		o.AdminPassword = password
+	}
+}
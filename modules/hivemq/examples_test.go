@@ -0,0 +1,37 @@
+package hivemq_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/hivemq"
+)
+
+func ExampleRunContainer() {
+	// runHiveMQContainer {
+	ctx := context.Background()
+
+	hivemqContainer, err := hivemq.RunContainer(ctx, testcontainers.WithImage("hivemq/hivemq4:4.24.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := hivemqContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := hivemqContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
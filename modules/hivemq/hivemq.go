@@ -0,0 +1,140 @@
+package hivemq
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	mqttPort          = "1883/tcp"
+	controlCenterPort = "8080/tcp"
+
+	extensionsPath = "/opt/hivemq/extensions"
+)
+
+// HiveMQContainer represents the HiveMQ container type used in the module
+type HiveMQContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the HiveMQ container type, running the HiveMQ 4 Enterprise image
+// in trial mode, with the MQTT listener, the control center and the allow-all extension of the image,
+// which accepts the connections of any client. The trial mode limits the number of concurrent connections.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*HiveMQContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "hivemq/hivemq4:4.24.0",
+		ExposedPorts: []string{mqttPort, controlCenterPort},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	strategies := []wait.Strategy{
+		wait.ForLog("Started HiveMQ in"),
+		wait.ForListeningPort(mqttPort),
+	}
+
+	for _, dir := range settings.Extensions {
+		descriptor, err := readExtensionDescriptor(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath: dir,
+			// the directory is copied with its base name into the extensions folder
+			ContainerFilePath: extensionsPath + "/" + filepath.Base(dir),
+			FileMode:          0o755,
+		})
+
+		if _, err := os.Stat(filepath.Join(dir, "DISABLED")); err == nil {
+			continue
+		}
+
+		strategies = append(strategies, wait.ForLog(fmt.Sprintf("Extension %q version %s started successfully.", descriptor.Name, descriptor.Version)))
+	}
+
+	if genericContainerReq.WaitingFor == nil {
+		genericContainerReq.WaitingFor = wait.ForAll(strategies...).WithDeadline(2 * time.Minute)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HiveMQContainer{Container: container}, nil
+}
+
+// extensionDescriptor is the content of the hivemq-extension.xml file of an extension.
+type extensionDescriptor struct {
+	ID      string `xml:"id"`
+	Name    string `xml:"name"`
+	Version string `xml:"version"`
+}
+
+// readExtensionDescriptor reads the hivemq-extension.xml file of the extension in the given host directory.
+func readExtensionDescriptor(dir string) (extensionDescriptor, error) {
+	var descriptor extensionDescriptor
+
+	content, err := os.ReadFile(filepath.Join(dir, "hivemq-extension.xml"))
+	if err != nil {
+		return descriptor, fmt.Errorf("failed to read the descriptor of the extension %s: %w", dir, err)
+	}
+
+	if err := xml.Unmarshal(content, &descriptor); err != nil {
+		return descriptor, fmt.Errorf("failed to parse the descriptor of the extension %s: %w", dir, err)
+	}
+
+	if strings.TrimSpace(descriptor.ID) == "" || strings.TrimSpace(descriptor.Name) == "" || strings.TrimSpace(descriptor.Version) == "" {
+		return descriptor, fmt.Errorf("the id, name and version of the extension %s are required", dir)
+	}
+
+	return descriptor, nil
+}
+
+// BrokerURL returns the URL of the MQTT listener, e.g. "tcp://localhost:32768", to be used by the MQTT clients.
+func (c *HiveMQContainer) BrokerURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "tcp", mqttPort)
+}
+
+// ControlCenterURL returns the URL of the control center, e.g. "http://localhost:32768", where the default
+// credentials are "admin" and "hivemq".
+func (c *HiveMQContainer) ControlCenterURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "http", controlCenterPort)
+}
+
+func (c *HiveMQContainer) endpoint(ctx context.Context, scheme string, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, mappedPort.Port())), nil
+}
@@ -0,0 +1,131 @@
+package hivemq_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/testcontainers/testcontainers-go/modules/hivemq"
+)
+
+func TestHiveMQ(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := hivemq.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("publish-subscribe", func(t *testing.T) {
+		// brokerURL {
+		brokerURL, err := container.BrokerURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(brokerURL))
+		token := client.Connect()
+		if !token.WaitTimeout(10*time.Second) || token.Error() != nil {
+			t.Fatalf("failed to connect: %v", token.Error())
+		}
+		defer client.Disconnect(250)
+
+		received := make(chan string, 1)
+		token = client.Subscribe("testcontainers/topic", 1, func(_ mqtt.Client, msg mqtt.Message) {
+			received <- string(msg.Payload())
+		})
+		if !token.WaitTimeout(10*time.Second) || token.Error() != nil {
+			t.Fatalf("failed to subscribe: %v", token.Error())
+		}
+
+		token = client.Publish("testcontainers/topic", 1, false, "hello")
+		if !token.WaitTimeout(10*time.Second) || token.Error() != nil {
+			t.Fatalf("failed to publish: %v", token.Error())
+		}
+
+		select {
+		case msg := <-received:
+			if msg != "hello" {
+				t.Fatalf("expected hello, got %s", msg)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the message")
+		}
+	})
+
+	t.Run("control-center", func(t *testing.T) {
+		// controlCenterURL {
+		controlCenterURL, err := container.ControlCenterURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.Get(controlCenterURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+	})
+}
+
+func TestHiveMQWithExtension(t *testing.T) {
+	ctx := context.Background()
+
+	// withExtension {
+	container, err := hivemq.RunContainer(ctx, hivemq.WithExtension("testdata/disabled-extension"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// the extension is disabled, so only its descriptor can be checked
+	r, err := container.CopyFileFromContainer(ctx, "/opt/hivemq/extensions/disabled-extension/hivemq-extension.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "<id>disabled-extension</id>") {
+		t.Fatalf("expected the descriptor of the extension, got %s", content)
+	}
+}
+
+func TestHiveMQWithInvalidExtension(t *testing.T) {
+	for _, dir := range []string{"testdata/invalid-extension", "testdata/missing-descriptor"} {
+		t.Run(dir, func(t *testing.T) {
+			_, err := hivemq.RunContainer(context.Background(), hivemq.WithExtension(dir))
+			if err == nil {
+				t.Fatal("expected an error for an invalid extension")
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package hivemq
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Extensions are the host directories of the extensions to install.
+	Extensions []string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the HiveMQ container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithExtension installs the extension in the given host directory, which contains the hivemq-extension.xml
+// descriptor and the jar file of the extension, as built by the HiveMQ extension SDK. The directory is copied
+// into the extensions folder of HiveMQ, and the container is ready once the extension is started, unless
+// the directory contains a DISABLED file. It can be called multiple times.
+func WithExtension(hostDir string) Option {
+	return func(o *options) {
+		o.Extensions = append(o.Extensions, hostDir)
+	}
+}
@@ -0,0 +1,37 @@
+package hydra_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/hydra"
+)
+
+func ExampleRunContainer() {
+	// runHydraContainer {
+	ctx := context.Background()
+
+	hydraContainer, err := hydra.RunContainer(ctx, testcontainers.WithImage("oryd/hydra:v2.2.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := hydraContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := hydraContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
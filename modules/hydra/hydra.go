@@ -0,0 +1,279 @@
+package hydra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	publicPort = "4444/tcp"
+	adminPort  = "4445/tcp"
+
+	// issuerPath is the file read by the entrypoint before starting Hydra, containing the URL of the issuer,
+	// which must be the URL of the public endpoint used by the clients running in the host.
+	issuerPath = "/tmp/testcontainers-issuer"
+)
+
+// Client is an OAuth2 client registered in Hydra.
+type Client struct {
+	// ID is the client ID, generated by Hydra if not set.
+	ID string `json:"client_id,omitempty"`
+	// Secret is the client secret, generated by Hydra if not set.
+	Secret string `json:"client_secret,omitempty"`
+	// Name is the display name of the client.
+	Name string `json:"client_name,omitempty"`
+	// GrantTypes are the allowed grant types, e.g. "client_credentials" or "authorization_code".
+	// Defaults to "authorization_code".
+	GrantTypes []string `json:"grant_types,omitempty"`
+	// ResponseTypes are the allowed response types, e.g. "code". Defaults to "code".
+	ResponseTypes []string `json:"response_types,omitempty"`
+	// RedirectURIs are the allowed redirect URIs of the client.
+	RedirectURIs []string `json:"redirect_uris,omitempty"`
+	// Scope is the space separated list of the scopes the client can request, e.g. "openid offline".
+	Scope string `json:"scope,omitempty"`
+	// Audience are the audiences the client can request.
+	Audience []string `json:"audience,omitempty"`
+	// TokenEndpointAuthMethod is the authentication method of the client at the token endpoint,
+	// e.g. "client_secret_post". Defaults to "client_secret_basic".
+	TokenEndpointAuthMethod string `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// Introspection is the result of the introspection of a token.
+type Introspection struct {
+	// Active reports whether the token is valid, i.e. issued by Hydra, not expired and not revoked.
+	Active bool `json:"active"`
+	// ClientID is the ID of the client the token was issued to.
+	ClientID string `json:"client_id"`
+	// Subject is the subject of the token, which is the client ID for the client credentials grant.
+	Subject string `json:"sub"`
+	// Scope is the space separated list of the granted scopes.
+	Scope string `json:"scope"`
+	// ExpiresAt is the expiration time of the token, in seconds since the Unix epoch.
+	ExpiresAt int64 `json:"exp"`
+}
+
+// HydraContainer represents the Hydra container type used in the module
+type HydraContainer struct {
+	testcontainers.Container
+	issuerURL string
+	clients   []Client
+}
+
+// RunContainer creates an instance of the Hydra container type, running Hydra in dev mode with the
+// in-memory storage. As the issuer URL must be the URL of the public endpoint used by the clients running
+// in the host, Hydra is started once the container is started and its port is mapped, and the clients set
+// by the options are registered once it's ready.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*HydraContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "oryd/hydra:v2.2.0",
+		ExposedPorts: []string{publicPort, adminPort},
+		Env: map[string]string{
+			"DSN":            "memory",
+			"SECRETS_SYSTEM": "testcontainers-hydra-system-secret",
+			"LOG_LEVEL":      "warn",
+		},
+		// wait for the issuer, which is copied after the container is started
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd: []string{
+			fmt.Sprintf("while [ ! -f %[1]s ]; do sleep 0.1; done; export URLS_SELF_ISSUER=$(cat %[1]s); exec hydra serve all --dev", issuerPath),
+		},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.LoginURL != "" {
+		genericContainerReq.Env["URLS_LOGIN"] = settings.LoginURL
+	}
+	if settings.ConsentURL != "" {
+		genericContainerReq.Env["URLS_CONSENT"] = settings.ConsentURL
+	}
+
+	// the wait strategy can only be checked once the issuer is copied
+	waitStrategy := genericContainerReq.WaitingFor
+	if waitStrategy == nil {
+		waitStrategy = wait.ForAll(
+			wait.ForHTTP("/health/ready").WithPort(publicPort),
+			wait.ForHTTP("/health/ready").WithPort(adminPort),
+		).WithDeadline(time.Minute)
+	}
+	genericContainerReq.WaitingFor = nil
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &HydraContainer{Container: container}
+
+	publicURL, err := c.url(ctx, publicPort)
+	if err != nil {
+		return nil, err
+	}
+	c.issuerURL = publicURL
+
+	if err := container.CopyToContainer(ctx, []byte(c.issuerURL), issuerPath, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to copy the issuer into the container: %w", err)
+	}
+
+	if err := waitStrategy.WaitUntilReady(ctx, container); err != nil {
+		return nil, fmt.Errorf("failed to wait for Hydra readiness: %w", err)
+	}
+
+	for _, client := range settings.Clients {
+		created, err := c.CreateClient(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		c.clients = append(c.clients, created)
+	}
+
+	return c, nil
+}
+
+// IssuerURL returns the URL of the OIDC issuer, e.g. "http://localhost:32768", which is the URL of the
+// public endpoint, serving the OAuth2 and OIDC endpoints, to be used by the OAuth2 clients.
+func (c *HydraContainer) IssuerURL() string {
+	return c.issuerURL
+}
+
+// DiscoveryURL returns the URL of the OpenID Provider configuration document.
+func (c *HydraContainer) DiscoveryURL() string {
+	return c.issuerURL + "/.well-known/openid-configuration"
+}
+
+// TokenURL returns the URL of the token endpoint.
+func (c *HydraContainer) TokenURL() string {
+	return c.issuerURL + "/oauth2/token"
+}
+
+// AuthURL returns the URL of the authorization endpoint.
+func (c *HydraContainer) AuthURL() string {
+	return c.issuerURL + "/oauth2/auth"
+}
+
+// PublicURL returns the URL of the public endpoint, e.g. "http://localhost:32768", which is the issuer URL.
+func (c *HydraContainer) PublicURL(ctx context.Context) (string, error) {
+	return c.url(ctx, publicPort)
+}
+
+// AdminURL returns the URL of the admin endpoint, e.g. "http://localhost:32768", serving the API managing
+// the clients, introspecting the tokens, and accepting the login and consent requests.
+func (c *HydraContainer) AdminURL(ctx context.Context) (string, error) {
+	return c.url(ctx, adminPort)
+}
+
+// Clients returns the clients registered with the WithClient option, in the same order, with their IDs and secrets.
+func (c *HydraContainer) Clients() []Client {
+	return c.clients
+}
+
+// CreateClient registers the given OAuth2 client, returning it with its ID and secret, generated by Hydra if not set.
+func (c *HydraContainer) CreateClient(ctx context.Context, client Client) (Client, error) {
+	body, err := json.Marshal(client)
+	if err != nil {
+		return Client{}, err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/admin/clients", "application/json", body, http.StatusCreated)
+	if err != nil {
+		return Client{}, err
+	}
+
+	var created Client
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return Client{}, err
+	}
+
+	return created, nil
+}
+
+// DeleteClient deletes the OAuth2 client with the given ID.
+func (c *HydraContainer) DeleteClient(ctx context.Context, id string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/admin/clients/"+url.PathEscape(id), "", nil, http.StatusNoContent)
+	return err
+}
+
+// IntrospectToken introspects the given access or refresh token, e.g. to check the tokens issued
+// to the application under test.
+func (c *HydraContainer) IntrospectToken(ctx context.Context, token string) (Introspection, error) {
+	var introspection Introspection
+
+	form := url.Values{"token": {token}}
+	respBody, err := c.do(ctx, http.MethodPost, "/admin/oauth2/introspect", "application/x-www-form-urlencoded", []byte(form.Encode()), http.StatusOK)
+	if err != nil {
+		return introspection, err
+	}
+
+	err = json.Unmarshal(respBody, &introspection)
+	return introspection, err
+}
+
+func (c *HydraContainer) url(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, mappedPort.Port())), nil
+}
+
+// do sends a request to the admin endpoint.
+func (c *HydraContainer) do(ctx context.Context, method string, path string, contentType string, body []byte, expectedStatus int) ([]byte, error) {
+	adminURL, err := c.AdminURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, adminURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
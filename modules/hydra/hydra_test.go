@@ -0,0 +1,146 @@
+package hydra_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/hydra"
+)
+
+func TestHydra(t *testing.T) {
+	ctx := context.Background()
+
+	// withClient {
+	container, err := hydra.RunContainer(ctx, hydra.WithClient(hydra.Client{
+		ID:         "backend",
+		Secret:     "backend-secret",
+		GrantTypes: []string{"client_credentials"},
+		Scope:      "read write",
+	}))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("discovery", func(t *testing.T) {
+		resp, err := http.Get(container.DiscoveryURL())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var discovery struct {
+			Issuer        string `json:"issuer"`
+			TokenEndpoint string `json:"token_endpoint"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+			t.Fatal(err)
+		}
+
+		if discovery.Issuer != container.IssuerURL() {
+			t.Fatalf("expected the issuer %s, got %s", container.IssuerURL(), discovery.Issuer)
+		}
+		if discovery.TokenEndpoint != container.TokenURL() {
+			t.Fatalf("expected the token endpoint %s, got %s", container.TokenURL(), discovery.TokenEndpoint)
+		}
+	})
+
+	t.Run("client-credentials", func(t *testing.T) {
+		clients := container.Clients()
+		if len(clients) != 1 || clients[0].ID != "backend" {
+			t.Fatalf("expected the registered client, got %+v", clients)
+		}
+
+		token := clientCredentialsToken(t, container.TokenURL(), clients[0], "read")
+
+		// introspectToken {
+		introspection, err := container.IntrospectToken(ctx, token)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !introspection.Active || introspection.ClientID != "backend" || introspection.Scope != "read" {
+			t.Fatalf("expected an active token for the client, got %+v", introspection)
+		}
+	})
+
+	t.Run("create-client", func(t *testing.T) {
+		// createClient {
+		client, err := container.CreateClient(ctx, hydra.Client{
+			GrantTypes: []string{"client_credentials"},
+		})
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if client.ID == "" || client.Secret == "" {
+			t.Fatalf("expected a generated ID and secret, got %+v", client)
+		}
+
+		clientCredentialsToken(t, container.TokenURL(), client, "")
+
+		if err := container.DeleteClient(ctx, client.ID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("invalid-token", func(t *testing.T) {
+		introspection, err := container.IntrospectToken(ctx, "invalid")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if introspection.Active {
+			t.Fatal("expected an inactive token")
+		}
+	})
+}
+
+// clientCredentialsToken requests an access token with the client credentials grant.
+func clientCredentialsToken(t *testing.T, tokenURL string, client hydra.Client, scope string) string {
+	t.Helper()
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if scope != "" {
+		form.Set("scope", scope)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(client.ID), url.QueryEscape(client.Secret))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	return result.AccessToken
+}
@@ -0,0 +1,46 @@
+package hydra
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Clients are the OAuth2 clients registered once Hydra is ready.
+	Clients []Client
+	// LoginURL is the URL of the login endpoint of the login and consent app.
+	LoginURL string
+	// ConsentURL is the URL of the consent endpoint of the login and consent app.
+	ConsentURL string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Hydra container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithClient registers the given OAuth2 client once Hydra is ready. The registered clients, with their
+// generated IDs and secrets if not set, are returned by the Clients method. It can be called multiple times.
+func WithClient(client Client) Option {
+	return func(o *options) {
+		o.Clients = append(o.Clients, client)
+	}
+}
+
+// WithLoginAndConsentURLs sets the URLs of the login and consent endpoints, to which Hydra redirects
+// the users in the authorization code flow, e.g. endpoints of the application under test running in the host.
+func WithLoginAndConsentURLs(loginURL string, consentURL string) Option {
+	return func(o *options) {
+		o.LoginURL = loginURL
+		o.ConsentURL = consentURL
+	}
+}
@@ -0,0 +1,37 @@
+package influxdb_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/influxdb"
+)
+
+func ExampleRunContainer() {
+	// runInfluxDBContainer {
+	ctx := context.Background()
+
+	influxdbContainer, err := influxdb.RunContainer(ctx, testcontainers.WithImage("influxdb:2.7.5-alpine"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := influxdbContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := influxdbContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,170 @@
+package influxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultUsername     = "admin"
+	defaultPassword     = "password"
+	defaultOrganization = "testcontainers"
+	defaultBucket       = "test"
+	defaultAdminToken   = "testcontainers-admin-token"
+
+	defaultPort = "8086/tcp"
+)
+
+// InfluxDBContainer represents the InfluxDB container type used in the module
+type InfluxDBContainer struct {
+	testcontainers.Container
+	settings options
+	v1       bool
+}
+
+// RunContainer creates an instance of the InfluxDB container type.
+// Both InfluxDB v1 and v2 images are supported, using the bootstrap flow of each version
+// depending on the tag of the image, e.g. "influxdb:1.8.10" or "influxdb:2.7.5-alpine".
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*InfluxDBContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "influxdb:2.7.5-alpine",
+		ExposedPorts: []string{defaultPort},
+		Env:          map[string]string{},
+		WaitingFor: wait.ForHTTP("/ping").WithPort(defaultPort).WithStatusCodeMatcher(func(status int) bool {
+			return status == http.StatusNoContent
+		}),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	v1 := isV1(genericContainerReq.Image)
+	if v1 {
+		configureV1(&genericContainerReq, settings)
+	} else if err := configureV2(&genericContainerReq, settings); err != nil {
+		return nil, err
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InfluxDBContainer{Container: container, settings: settings, v1: v1}, nil
+}
+
+// isV1 returns true if the tag of the image belongs to InfluxDB v1, e.g. "1.8" or "1.8.10-alpine".
+func isV1(image string) bool {
+	// the registry could include a port, so the tag is the part after the last colon,
+	// as long as it's not part of the path
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return false
+	}
+
+	return strings.HasPrefix(image[i+1:], "1.")
+}
+
+// configureV1 creates the admin user and the database, enabling the authentication.
+func configureV1(req *testcontainers.GenericContainerRequest, settings options) {
+	req.Env["INFLUXDB_HTTP_AUTH_ENABLED"] = "true"
+	req.Env["INFLUXDB_ADMIN_USER"] = settings.Username
+	req.Env["INFLUXDB_ADMIN_PASSWORD"] = settings.Password
+	if settings.Database != "" {
+		req.Env["INFLUXDB_DB"] = settings.Database
+	}
+}
+
+// configureV2 makes the entrypoint run "influx setup", creating the admin user, the organization,
+// the bucket and the admin token before the server is started.
+func configureV2(req *testcontainers.GenericContainerRequest, settings options) error {
+	if len(settings.Password) < 8 {
+		return errors.New("the password must be at least 8 characters long")
+	}
+
+	if settings.Organization == "" || settings.Bucket == "" {
+		return errors.New("the organization and the bucket cannot be empty")
+	}
+
+	req.Env["DOCKER_INFLUXDB_INIT_MODE"] = "setup"
+	req.Env["DOCKER_INFLUXDB_INIT_USERNAME"] = settings.Username
+	req.Env["DOCKER_INFLUXDB_INIT_PASSWORD"] = settings.Password
+	req.Env["DOCKER_INFLUXDB_INIT_ORG"] = settings.Organization
+	req.Env["DOCKER_INFLUXDB_INIT_BUCKET"] = settings.Bucket
+	req.Env["DOCKER_INFLUXDB_INIT_ADMIN_TOKEN"] = settings.AdminToken
+
+	return nil
+}
+
+// URL returns the URL of the HTTP API of the InfluxDB container, in the format http://host:port.
+func (c *InfluxDBContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, defaultPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// Token returns the token of the admin user. It's empty for InfluxDB v1.
+func (c *InfluxDBContainer) Token() string {
+	if c.v1 {
+		return ""
+	}
+	return c.settings.AdminToken
+}
+
+// Organization returns the name of the initial organization. It's empty for InfluxDB v1.
+func (c *InfluxDBContainer) Organization() string {
+	if c.v1 {
+		return ""
+	}
+	return c.settings.Organization
+}
+
+// Bucket returns the name of the initial bucket. It's empty for InfluxDB v1.
+func (c *InfluxDBContainer) Bucket() string {
+	if c.v1 {
+		return ""
+	}
+	return c.settings.Bucket
+}
+
+// Database returns the name of the database created when the container started. It's empty for InfluxDB v2.
+func (c *InfluxDBContainer) Database() string {
+	if c.v1 {
+		return c.settings.Database
+	}
+	return ""
+}
+
+// Username returns the name of the admin user.
+func (c *InfluxDBContainer) Username() string {
+	return c.settings.Username
+}
+
+// Password returns the password of the admin user.
+func (c *InfluxDBContainer) Password() string {
+	return c.settings.Password
+}
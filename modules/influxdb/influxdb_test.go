@@ -0,0 +1,145 @@
+package influxdb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestInfluxDB(t *testing.T) {
+	ctx := context.Background()
+
+	// runInfluxDBV2 {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("influxdb:2.7.5-alpine"),
+		WithOrganization("acme"),
+		WithBucket("metrics"),
+		WithAdminToken("my-secret-token"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	influxURL, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, influxURL+"/api/v2/buckets?name="+container.Bucket(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Token "+container.Token())
+
+	body := doRequest(t, req)
+	if !strings.Contains(body, `"name": "metrics"`) {
+		t.Fatalf("expected the metrics bucket to exist, got %s", body)
+	}
+}
+
+func TestInfluxDBV1(t *testing.T) {
+	ctx := context.Background()
+
+	// runInfluxDBV1 {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("influxdb:1.8.10"),
+		WithUsername("root"),
+		WithPassword("s3cr3t"),
+		WithDatabase("telegraf"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if container.Token() != "" {
+		t.Fatalf("expected no token for InfluxDB v1, got %s", container.Token())
+	}
+
+	influxURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, influxURL+"/query?q="+url.QueryEscape("SHOW DATABASES"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(container.Username(), container.Password())
+
+	body := doRequest(t, req)
+	if !strings.Contains(body, "telegraf") {
+		t.Fatalf("expected the telegraf database to exist, got %s", body)
+	}
+}
+
+func TestInfluxDBV2WithShortPassword(t *testing.T) {
+	_, err := RunContainer(context.Background(), WithPassword("short"))
+	if err == nil {
+		t.Fatal("expected an error for a password shorter than 8 characters")
+	}
+}
+
+func TestIsV1(t *testing.T) {
+	tests := []struct {
+		image string
+		v1    bool
+	}{
+		{image: "influxdb:1.8.10", v1: true},
+		{image: "docker.io/influxdb:1.8-alpine", v1: true},
+		{image: "localhost:5000/influxdb:1.8", v1: true},
+		{image: "influxdb:2.7.5-alpine", v1: false},
+		{image: "influxdb", v1: false},
+		{image: "localhost:5000/influxdb", v1: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := isV1(tt.image); got != tt.v1 {
+				t.Fatalf("expected %v for %s, got %v", tt.v1, tt.image, got)
+			}
+		})
+	}
+}
+
+func doRequest(t *testing.T, req *http.Request) string {
+	t.Helper()
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d: %s", http.StatusOK, resp.StatusCode, body)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,89 @@
+package influxdb
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Username is the name of the admin user, for both InfluxDB v1 and v2.
+	Username string
+	// Password is the password of the admin user, for both InfluxDB v1 and v2.
+	Password string
+	// Database is the name of the database created when the container starts. Only used by InfluxDB v1.
+	Database string
+	// Organization is the name of the initial organization. Only used by InfluxDB v2.
+	Organization string
+	// Bucket is the name of the initial bucket. Only used by InfluxDB v2.
+	Bucket string
+	// AdminToken is the token of the admin user. Only used by InfluxDB v2.
+	AdminToken string
+}
+
+func defaultOptions() options {
+	return options{
+		Username:     defaultUsername,
+		Password:     defaultPassword,
+		Organization: defaultOrganization,
+		Bucket:       defaultBucket,
+		AdminToken:   defaultAdminToken,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the InfluxDB container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUsername sets the name of the admin user, for both InfluxDB v1 and v2.
+// If it is not specified, then the "admin" user will be used.
+func WithUsername(username string) Option {
+	return func(o *options) {
+		o.Username = username
+	}
+}
+
+// WithPassword sets the password of the admin user, for both InfluxDB v1 and v2.
+// InfluxDB v2 requires it to be at least 8 characters long.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.Password = password
+	}
+}
+
+// WithDatabase sets the name of the database to be created when an InfluxDB v1 container starts.
+// It's ignored by InfluxDB v2, which uses buckets instead.
+func WithDatabase(database string) Option {
+	return func(o *options) {
+		o.Database = database
+	}
+}
+
+// WithOrganization sets the name of the initial organization of an InfluxDB v2 container.
+// If it is not specified, then the "testcontainers" organization will be used.
+func WithOrganization(org string) Option {
+	return func(o *options) {
+		o.Organization = org
+	}
+}
+
+// WithBucket sets the name of the initial bucket of an InfluxDB v2 container.
+// If it is not specified, then the "test" bucket will be used.
+func WithBucket(bucket string) Option {
+	return func(o *options) {
+		o.Bucket = bucket
+	}
+}
+
+// WithAdminToken sets the token of the admin user of an InfluxDB v2 container,
+// so that it is known in advance by the clients.
+func WithAdminToken(token string) Option {
+	return func(o *options) {
+		o.AdminToken = token
+	}
+}
@@ -0,0 +1,37 @@
+package jaeger_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/jaeger"
+)
+
+func ExampleRunContainer() {
+	// runJaegerContainer {
+	ctx := context.Background()
+
+	jaegerContainer, err := jaeger.RunContainer(ctx, testcontainers.WithImage("jaegertracing/all-in-one:1.53.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := jaegerContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := jaegerContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,105 @@
+package jaeger
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	otlpGRPCPort = "4317/tcp"
+	otlpHTTPPort = "4318/tcp"
+	queryPort    = "16686/tcp"
+	adminPort    = "14269/tcp"
+)
+
+// JaegerContainer represents the Jaeger container type used in the module
+type JaegerContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Jaeger container type, running the all-in-one distribution
+// with the in-memory storage, which receives the traces with the OTLP protocol.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*JaegerContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "jaegertracing/all-in-one:1.53.0",
+		ExposedPorts: []string{otlpGRPCPort, otlpHTTPPort, queryPort, adminPort},
+		Env: map[string]string{
+			"COLLECTOR_OTLP_ENABLED": "true",
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/").WithPort(adminPort),
+			wait.ForListeningPort(otlpGRPCPort),
+			wait.ForListeningPort(otlpHTTPPort),
+		).WithDeadline(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JaegerContainer{Container: container}, nil
+}
+
+// OTLPGRPCEndpoint returns the "host:port" endpoint of the OTLP gRPC receiver, to be used by the
+// OpenTelemetry trace exporter, e.g. with otlptracegrpc.WithEndpoint and otlptracegrpc.WithInsecure.
+func (c *JaegerContainer) OTLPGRPCEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpGRPCPort)
+}
+
+// OTLPHTTPEndpoint returns the "host:port" endpoint of the OTLP HTTP receiver, to be used by the
+// OpenTelemetry trace exporter, e.g. with otlptracehttp.WithEndpoint and otlptracehttp.WithInsecure.
+func (c *JaegerContainer) OTLPHTTPEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpHTTPPort)
+}
+
+// OTLPTracesURL returns the URL of the OTLP HTTP traces endpoint, e.g. "http://localhost:32768/v1/traces",
+// to be used by the OpenTelemetry trace exporter, e.g. with otlptracehttp.WithEndpointURL, or with the
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variable.
+func (c *JaegerContainer) OTLPTracesURL(ctx context.Context) (string, error) {
+	endpoint, err := c.endpoint(ctx, otlpHTTPPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + endpoint + "/v1/traces", nil
+}
+
+// QueryURL returns the base URL of the Jaeger UI and query API, e.g. "http://localhost:32768".
+func (c *JaegerContainer) QueryURL(ctx context.Context) (string, error) {
+	endpoint, err := c.endpoint(ctx, queryPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + endpoint, nil
+}
+
+func (c *JaegerContainer) endpoint(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
@@ -0,0 +1,126 @@
+package jaeger_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/jaeger"
+)
+
+// otlpSpan is a span in the OTLP JSON encoding, sent as is to the OTLP HTTP receiver
+// to avoid depending on the OpenTelemetry SDK.
+const otlpSpan = `{
+  "resourceSpans": [{
+    "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "checkout"}}]},
+    "scopeSpans": [{
+      "spans": [{
+        "traceId": "5b8efff798038103d269b633813fc60c",
+        "spanId": "eee19b7ec3c1b174",
+        "name": "place-order",
+        "kind": 2,
+        "startTimeUnixNano": "%d",
+        "endTimeUnixNano": "%d",
+        "attributes": [{"key": "order.id", "value": {"stringValue": "42"}}]
+      }]
+    }]
+  }]
+}`
+
+func TestJaeger(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := jaeger.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// otlpTracesURL {
+	tracesURL, err := container.OTLPTracesURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	body := fmt.Sprintf(otlpSpan, start.UnixNano(), start.Add(time.Millisecond).UnixNano())
+	resp, err := http.Post(tracesURL, "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	// the spans are stored asynchronously
+	// findTraces {
+	var traces []jaeger.Trace
+	deadline := time.Now().Add(30 * time.Second)
+	for len(traces) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the trace to be stored")
+		}
+
+		time.Sleep(500 * time.Millisecond)
+
+		traces, err = container.FindTraces(ctx, "checkout")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// }
+
+	if len(traces) != 1 || len(traces[0].Spans) != 1 {
+		t.Fatalf("expected a single trace with a single span, got %v", traces)
+	}
+
+	span := traces[0].Spans[0]
+	if span.OperationName != "place-order" {
+		t.Fatalf("expected the place-order operation, got %s", span.OperationName)
+	}
+
+	if value, ok := span.Tag("order.id"); !ok || value != "42" {
+		t.Fatalf("expected the order.id tag to be 42, got %v", value)
+	}
+
+	// getTrace {
+	trace, err := container.GetTrace(ctx, "5b8efff798038103d269b633813fc60c")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if trace.Processes[span.ProcessID].ServiceName != "checkout" {
+		t.Fatalf("expected the checkout service, got %v", trace.Processes)
+	}
+
+	services, err := container.Services(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, service := range services {
+		found = found || service == "checkout"
+	}
+	if !found {
+		t.Fatalf("expected the checkout service, got %v", services)
+	}
+
+	_, err = container.GetTrace(ctx, "00000000000000000000000000000001")
+	if err == nil {
+		t.Fatal("expected an error for an unknown trace")
+	}
+}
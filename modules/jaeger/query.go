@@ -0,0 +1,128 @@
+package jaeger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Trace is a trace stored by Jaeger, as returned by the query API.
+type Trace struct {
+	TraceID   string             `json:"traceID"`
+	Spans     []Span             `json:"spans"`
+	Processes map[string]Process `json:"processes"`
+}
+
+// Span is a span of a trace. The start time and the duration are in microseconds.
+type Span struct {
+	TraceID       string      `json:"traceID"`
+	SpanID        string      `json:"spanID"`
+	OperationName string      `json:"operationName"`
+	References    []Reference `json:"references"`
+	StartTime     int64       `json:"startTime"`
+	Duration      int64       `json:"duration"`
+	Tags          []KeyValue  `json:"tags"`
+	ProcessID     string      `json:"processID"`
+}
+
+// Reference is a reference of a span to another span, e.g. its parent with the "CHILD_OF" type.
+type Reference struct {
+	RefType string `json:"refType"`
+	TraceID string `json:"traceID"`
+	SpanID  string `json:"spanID"`
+}
+
+// Process is the process emitting spans, with the service name of the OpenTelemetry resource.
+type Process struct {
+	ServiceName string     `json:"serviceName"`
+	Tags        []KeyValue `json:"tags"`
+}
+
+// KeyValue is a tag of a span or a process, e.g. an attribute of the OpenTelemetry span.
+type KeyValue struct {
+	Key   string      `json:"key"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Tag returns the value of the tag with the given key, and whether the span has the tag.
+func (s Span) Tag(key string) (interface{}, bool) {
+	for _, kv := range s.Tags {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// Services returns the names of the services which have sent traces.
+func (c *JaegerContainer) Services(ctx context.Context) ([]string, error) {
+	var services []string
+	if err := c.query(ctx, "/api/services", &services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// FindTraces returns the traces of the given service. The traces are stored asynchronously,
+// so the tests usually retry until the expected traces are returned.
+func (c *JaegerContainer) FindTraces(ctx context.Context, service string) ([]Trace, error) {
+	var traces []Trace
+	if err := c.query(ctx, "/api/traces?service="+url.QueryEscape(service), &traces); err != nil {
+		return nil, err
+	}
+
+	return traces, nil
+}
+
+// GetTrace returns the trace with the given ID, in its hexadecimal representation.
+func (c *JaegerContainer) GetTrace(ctx context.Context, traceID string) (Trace, error) {
+	var traces []Trace
+	if err := c.query(ctx, "/api/traces/"+url.PathEscape(traceID), &traces); err != nil {
+		return Trace{}, err
+	}
+
+	if len(traces) == 0 {
+		return Trace{}, fmt.Errorf("trace %s not found", traceID)
+	}
+
+	return traces[0], nil
+}
+
+// query sends a GET request to the query API, decoding the data of the response into v.
+func (c *JaegerContainer) query(ctx context.Context, path string, v interface{}) error {
+	queryURL, err := c.QueryURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d for GET %s: %s", resp.StatusCode, path, body)
+	}
+
+	result := struct {
+		Data interface{} `json:"data"`
+	}{Data: v}
+	return json.Unmarshal(body, &result)
+}
@@ -2,6 +2,7 @@ package k3s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -22,6 +24,8 @@ var (
 	defaultRancherWebhookPort = "8443/tcp"
 	// }
 	defaultKubeConfigK3sPath = "/etc/rancher/k3s/k3s.yaml"
+	// defaultManifestsPath is the directory watched by k3s, which applies the manifests in it.
+	defaultManifestsPath = "/var/lib/rancher/k3s/server/manifests"
 )
 
 // K3sContainer represents the K3s container type used in the module
@@ -79,6 +83,19 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &K3sContainer{Container: container}, nil
 }
 
+// WithManifest copies the given manifest into the manifests directory of k3s, so that it is applied
+// when the cluster starts, in the same way as "kubectl apply" does. It can be called multiple times.
+// The manifests are applied asynchronously, so the resources could not exist right after the container is ready.
+func WithManifest(manifestPath string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Files = append(req.Files, testcontainers.ContainerFile{
+			HostFilePath:      manifestPath,
+			ContainerFilePath: defaultManifestsPath + "/" + filepath.Base(manifestPath),
+			FileMode:          0o644,
+		})
+	}
+}
+
 func getContainerHost(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (string, error) {
 	// Use a dummy request to get the provider from options.
 	var req testcontainers.GenericContainerRequest
@@ -166,37 +183,58 @@ func unmarshal(bytes []byte) (*KubeConfigValue, error) {
 	return &kubeConfig, nil
 }
 
-// LoadImages loads images into the k3s container.
+// LoadImages loads images into the k3s container, importing them into the embedded containerd,
+// so that pods can use images that were built locally and never pushed to a registry.
+// The images must be present in the Docker daemon running the tests, and pods must not
+// pull them, e.g. using the "Never" or "IfNotPresent" image pull policies.
 func (c *K3sContainer) LoadImages(ctx context.Context, images ...string) error {
+	if len(images) == 0 {
+		return errors.New("no images to load")
+	}
+
 	provider, err := testcontainers.ProviderDocker.GetProvider()
 	if err != nil {
 		return fmt.Errorf("getting docker provider %w", err)
 	}
+	defer provider.Close()
 
 	// save image
 	imagesTar, err := os.CreateTemp(os.TempDir(), "images*.tar")
 	if err != nil {
 		return fmt.Errorf("creating temporary images file %w", err)
 	}
+	// the file is written by the provider, so it can be closed right away
+	_ = imagesTar.Close()
 	defer func() {
 		_ = os.Remove(imagesTar.Name())
 	}()
 
-	err = provider.SaveImages(context.Background(), imagesTar.Name(), images...)
+	err = provider.SaveImages(ctx, imagesTar.Name(), images...)
 	if err != nil {
 		return fmt.Errorf("saving images %w", err)
 	}
 
 	containerPath := fmt.Sprintf("/tmp/%s", filepath.Base(imagesTar.Name()))
-	err = c.Container.CopyFileToContainer(ctx, imagesTar.Name(), containerPath, 0x644)
+	err = c.Container.CopyFileToContainer(ctx, imagesTar.Name(), containerPath, 0o644)
 	if err != nil {
 		return fmt.Errorf("copying image to container %w", err)
 	}
 
-	_, _, err = c.Container.Exec(ctx, []string{"ctr", "-n=k8s.io", "images", "import", containerPath})
+	code, output, err := c.Container.Exec(ctx, []string{"ctr", "-n=k8s.io", "images", "import", containerPath}, exec.Multiplexed())
 	if err != nil {
 		return fmt.Errorf("importing image %w", err)
 	}
 
+	if code != 0 {
+		out, _ := io.ReadAll(output)
+		return fmt.Errorf("importing image, exit code %d: %s", code, string(out))
+	}
+
+	// the images are already in containerd, so the tar file is not needed anymore
+	_, _, err = c.Container.Exec(ctx, []string{"rm", "-f", containerPath})
+	if err != nil {
+		return fmt.Errorf("removing images file %w", err)
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package k3s_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -64,8 +65,17 @@ func Test_LoadImages(t *testing.T) {
 		}
 	})
 
+	t.Run("Test load no images", func(t *testing.T) {
+		err := k3sContainer.LoadImages(context.Background())
+		if err == nil {
+			t.Fatal("should had failed")
+		}
+	})
+
 	t.Run("Test load image in cluster", func(t *testing.T) {
+		// loadImages {
 		err := k3sContainer.LoadImages(context.Background(), "nginx")
+		// }
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -161,3 +171,56 @@ func Test_APIServerReady(t *testing.T) {
 		t.Fatalf("failed to create pod %v", err)
 	}
 }
+
+func Test_WithManifest(t *testing.T) {
+	ctx := context.Background()
+
+	// withManifest {
+	k3sContainer, err := k3s.RunContainer(ctx,
+		k3s.WithManifest(filepath.Join("testdata", "configmap.yaml")),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := k3sContainer.Terminate(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	kubeConfigYaml, err := k3sContainer.GetKubeConfig(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restcfg, err := clientcmd.RESTConfigFromKubeConfig(kubeConfigYaml)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k8s, err := kubernetes.NewForConfig(restcfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the manifests are applied asynchronously by k3s
+	var configMap *corev1.ConfigMap
+	deadline := time.Now().Add(time.Minute)
+	for {
+		configMap, err = k8s.CoreV1().ConfigMaps("default").Get(ctx, "testcontainers", metav1.GetOptions{})
+		if err == nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	if err != nil {
+		t.Fatalf("failed to get the config map from the manifest: %v", err)
+	}
+
+	if configMap.Data["greeting"] != "hello" {
+		t.Fatalf("expected greeting to be hello, got %s", configMap.Data["greeting"])
+	}
+}
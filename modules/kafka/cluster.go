@@ -0,0 +1,160 @@
+package kafka
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+// KafkaCluster represents a group of Kafka brokers running in KRaft mode, attached to the
+// same Docker network and sharing the same cluster ID.
+type KafkaCluster struct {
+	// ClusterID is the KRaft cluster ID used to format the storage of all the brokers.
+	ClusterID string
+	// Containers holds the brokers of the cluster, ordered by node ID.
+	Containers []*KafkaContainer
+	// Network is the Docker network shared by the brokers.
+	Network *testcontainers.DockerNetwork
+}
+
+// RunCluster creates a Kafka cluster with the given number of brokers, all of them acting
+// as broker and controller. Each broker is reachable from other containers in the cluster
+// network using the "kafka-<node ID>" alias, and from the host using the addresses returned
+// by the Brokers method. The options are applied to every broker of the cluster.
+func RunCluster(ctx context.Context, size int, opts ...testcontainers.ContainerCustomizer) (*KafkaCluster, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("invalid cluster size %d (must be at least 1)", size)
+	}
+
+	clusterID, err := newKRaftClusterID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cluster ID: %w", err)
+	}
+
+	nw, err := network.New(ctx, network.WithCheckDuplicate())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	cluster := &KafkaCluster{
+		ClusterID:  clusterID,
+		Containers: make([]*KafkaContainer, size),
+		Network:    nw,
+	}
+
+	voters := make([]string, size)
+	for i := 0; i < size; i++ {
+		voters[i] = fmt.Sprintf("%d@%s:9094", i+1, brokerAlias(i+1))
+	}
+
+	// internal topics cannot have a replication factor greater than the number of brokers.
+	replicationFactor := "3"
+	if size < 3 {
+		replicationFactor = strconv.Itoa(size)
+	}
+
+	// all the brokers must be started at the same time, as each one of them
+	// waits for the controller quorum to be formed before being ready.
+	var wg sync.WaitGroup
+	errs := make([]error, size)
+	for i := 0; i < size; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			nodeID := strconv.Itoa(i + 1)
+			brokerOpts := append([]testcontainers.ContainerCustomizer{}, opts...)
+			brokerOpts = append(brokerOpts,
+				network.WithNetwork([]string{brokerAlias(i + 1)}, nw),
+				testcontainers.CustomizeRequestOption(func(req *testcontainers.GenericContainerRequest) {
+					req.Env["CLUSTER_ID"] = clusterID
+					req.Env["KRAFT_CLUSTER_ID"] = clusterID
+					req.Env["KAFKA_NODE_ID"] = nodeID
+					req.Env["KAFKA_BROKER_ID"] = nodeID
+					req.Env["KAFKA_CONTROLLER_QUORUM_VOTERS"] = strings.Join(voters, ",")
+					req.Env["KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR"] = replicationFactor
+					req.Env["KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR"] = replicationFactor
+				}),
+			)
+
+			cluster.Containers[i], errs[i] = RunContainer(ctx, brokerOpts...)
+		}(i)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		if terminateErr := cluster.Terminate(ctx); terminateErr != nil {
+			err = errors.Join(err, terminateErr)
+		}
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// Brokers retrieves the connection strings of all the brokers in the cluster,
+// defined by the exposed public port of each broker.
+func (kc *KafkaCluster) Brokers(ctx context.Context) ([]string, error) {
+	brokers := make([]string, 0, len(kc.Containers))
+	for _, c := range kc.Containers {
+		bs, err := c.Brokers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		brokers = append(brokers, bs...)
+	}
+
+	return brokers, nil
+}
+
+// Terminate terminates all the brokers of the cluster and removes the cluster network.
+func (kc *KafkaCluster) Terminate(ctx context.Context) error {
+	var errs []error
+	for _, c := range kc.Containers {
+		if c == nil {
+			continue
+		}
+		if err := c.Terminate(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if kc.Network != nil {
+		if err := kc.Network.Remove(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// brokerAlias returns the network alias of the broker with the given node ID.
+func brokerAlias(nodeID int) string {
+	return fmt.Sprintf("kafka-%d", nodeID)
+}
+
+// newKRaftClusterID generates a random cluster ID in the format expected by
+// kafka-storage, which is a base64 URL-safe encoded UUID without padding.
+func newKRaftClusterID() (string, error) {
+	for {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+
+		// kafka-storage does not generate IDs starting with a dash,
+		// as they could be mistaken for command line flags.
+		id := base64.RawURLEncoding.EncodeToString(b)
+		if !strings.HasPrefix(id, "-") {
+			return id, nil
+		}
+	}
+}
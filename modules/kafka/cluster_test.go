@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+func TestKafkaCluster(t *testing.T) {
+	ctx := context.Background()
+
+	// runKafkaCluster {
+	cluster, err := RunCluster(ctx, 3)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the cluster after the test is complete
+	t.Cleanup(func() {
+		if err := cluster.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate cluster: %s", err)
+		}
+	})
+
+	// getClusterBrokers {
+	brokers, err := cluster.Brokers(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForLocal
+
+	admin, err := sarama.NewClusterAdmin(brokers, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer admin.Close()
+
+	nodes, _, err := admin.DescribeCluster()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 3 {
+		t.Fatalf("expected 3 brokers in the cluster, got %d", len(nodes))
+	}
+
+	topic := "replicated-topic"
+	if err := admin.CreateTopic(topic, &sarama.TopicDetail{NumPartitions: 3, ReplicationFactor: 3}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// stop one of the brokers, the topic must still be available
+	// as its partitions are replicated in the other brokers.
+	timeout := 10 * time.Second
+	if err := cluster.Containers[2].Stop(ctx, &timeout); err != nil {
+		t.Fatal(err)
+	}
+
+	producer, err := sarama.NewSyncProducer(brokers[:2], config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer producer.Close()
+
+	if _, _, err := producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder("key"),
+		Value: sarama.StringEncoder("value"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKafkaCluster_invalidSize(t *testing.T) {
+	_, err := RunCluster(context.Background(), 0)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestNewKRaftClusterID(t *testing.T) {
+	id, err := newKRaftClusterID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 16 bytes encoded in base64 without padding
+	if len(id) != 22 {
+		t.Fatalf("expected cluster ID to have 22 characters, got %d (%s)", len(id), id)
+	}
+	if strings.HasPrefix(id, "-") {
+		t.Fatalf("expected cluster ID not to start with a dash, got %s", id)
+	}
+}
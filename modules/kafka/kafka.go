@@ -20,10 +20,10 @@ const (
 	// starterScript {
 	starterScriptContent = `#!/bin/bash
 source /etc/confluent/docker/bash-config
-export KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://%s:%d,BROKER://%s:9092
+export KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://%s:%d,BROKER://$(hostname):9092
 echo Starting Kafka KRaft mode
 sed -i '/KAFKA_ZOOKEEPER_CONNECT/d' /etc/confluent/docker/configure
-echo 'kafka-storage format --ignore-formatted -t "$(kafka-storage random-uuid)" -c /etc/kafka/kafka.properties' >> /etc/confluent/docker/configure
+echo 'kafka-storage format --ignore-formatted -t "${KRAFT_CLUSTER_ID:-$(kafka-storage random-uuid)}" -c /etc/kafka/kafka.properties' >> /etc/confluent/docker/configure
 echo '' > /etc/confluent/docker/ensure
 /etc/confluent/docker/configure
 /etc/confluent/docker/launch`
@@ -77,7 +77,10 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 							return err
 						}
 
-						scriptContent := fmt.Sprintf(starterScriptContent, host, port.Int(), host)
+						// the PLAINTEXT listener is advertised with the host and the mapped port, so that
+						// clients running on the host can reach the broker, while the BROKER listener is
+						// advertised with the container hostname, used for inter-broker communication.
+						scriptContent := fmt.Sprintf(starterScriptContent, host, port.Int())
 
 						return c.CopyToContainer(ctx, []byte(scriptContent), starterScript, 0o755)
 					},
@@ -116,6 +119,8 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &KafkaContainer{Container: container, ClusterID: clusterID}, nil
 }
 
+// WithClusterID sets the CLUSTER_ID environment variable of the Kafka container,
+// which is exposed in the ClusterID field of the returned container.
 func WithClusterID(clusterID string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["CLUSTER_ID"] = clusterID
@@ -2,12 +2,15 @@ package kafka
 
 import (
 	"context"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/IBM/sarama"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/network"
 )
 
 func TestKafka(t *testing.T) {
@@ -84,6 +87,76 @@ func TestKafka(t *testing.T) {
 	}
 }
 
+func TestKafka_networkedClient(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx, network.WithCheckDuplicate())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	kafkaContainer, err := RunContainer(ctx, network.WithNetwork([]string{"kafka"}, nw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := kafkaContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	kcat, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:      "confluentinc/cp-kcat:7.4.1",
+			Networks:   []string{nw.Name},
+			Entrypoint: []string{"sh"},
+			Cmd:        []string{"-c", "tail -f /dev/null"},
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := kcat.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate kcat container: %s", err)
+		}
+	})
+
+	// the broker listener is advertised with the container hostname, which is
+	// resolvable from any other container in the same network, so the messages
+	// are produced and consumed through it once the bootstrap metadata is fetched.
+	code, _, err := kcat.Exec(ctx, []string{"sh", "-c", "echo 'Message produced by kcat' | kcat -b kafka:9092 -t msgs -P"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != 0 {
+		t.Fatalf("expected kcat to produce the message, got exit code %d", code)
+	}
+
+	code, reader, err := kcat.Exec(ctx, []string{"kcat", "-b", "kafka:9092", "-t", "msgs", "-C", "-c", "1", "-e"}, exec.Multiplexed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != 0 {
+		t.Fatalf("expected kcat to consume the message, got exit code %d", code)
+	}
+
+	out, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(out), "Message produced by kcat") {
+		t.Fatalf("expected the consumed message to be produced by kcat, got %q", out)
+	}
+}
+
 func TestKafka_invalidVersion(t *testing.T) {
 	ctx := context.Background()
 
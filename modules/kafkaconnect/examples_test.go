@@ -0,0 +1,69 @@
+package kafkaconnect_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/kafkaconnect"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func ExampleRunContainer() {
+	// runKafkaConnectContainer {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := nw.Remove(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	redpandaContainer, err := redpanda.RunContainer(ctx,
+		network.WithNetwork([]string{"redpanda"}, nw),
+		redpanda.WithListener("redpanda:29092"),
+		redpanda.WithAutoCreateTopics(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := redpandaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	kafkaConnectContainer, err := kafkaconnect.RunContainer(ctx,
+		testcontainers.WithImage("confluentinc/cp-kafka-connect:7.5.0"),
+		network.WithNetwork([]string{"kafka-connect"}, nw),
+		kafkaconnect.WithBootstrapServers("redpanda:29092"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := kafkaConnectContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := kafkaConnectContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
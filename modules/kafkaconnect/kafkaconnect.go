@@ -0,0 +1,203 @@
+package kafkaconnect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	restPort = "8083/tcp"
+
+	// pluginsPath is the directory of the container where the plugins set with WithPlugins are copied,
+	// which is part of the plugin path of the worker.
+	pluginsPath = "/etc/kafka-connect/plugins"
+)
+
+// KafkaConnectContainer represents the Kafka Connect container type used in the module
+type KafkaConnectContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Kafka Connect container type, running a single worker
+// in distributed mode. The worker must join the network of a Kafka or Redpanda cluster,
+// using network.WithNetwork, and the bootstrap servers of the cluster must be set with
+// the WithBootstrapServers option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*KafkaConnectContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "confluentinc/cp-kafka-connect:7.5.0",
+		ExposedPorts: []string{restPort},
+		Env: map[string]string{
+			"CONNECT_REST_PORT":                         "8083",
+			"CONNECT_REST_ADVERTISED_HOST_NAME":         "localhost",
+			"CONNECT_GROUP_ID":                          "testcontainers-connect",
+			"CONNECT_CONFIG_STORAGE_TOPIC":              "_connect-configs",
+			"CONNECT_OFFSET_STORAGE_TOPIC":              "_connect-offsets",
+			"CONNECT_STATUS_STORAGE_TOPIC":              "_connect-status",
+			"CONNECT_CONFIG_STORAGE_REPLICATION_FACTOR": "1",
+			"CONNECT_OFFSET_STORAGE_REPLICATION_FACTOR": "1",
+			"CONNECT_STATUS_STORAGE_REPLICATION_FACTOR": "1",
+			"CONNECT_KEY_CONVERTER":                     "org.apache.kafka.connect.json.JsonConverter",
+			"CONNECT_VALUE_CONVERTER":                   "org.apache.kafka.connect.json.JsonConverter",
+			"CONNECT_PLUGIN_PATH":                       "/usr/share/java,/usr/share/confluent-hub-components," + pluginsPath,
+		},
+		WaitingFor: wait.ForHTTP("/connectors").WithPort(restPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env["CONNECT_BOOTSTRAP_SERVERS"] == "" {
+		return nil, errors.New("the bootstrap servers are required, use the WithBootstrapServers option")
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaConnectContainer{Container: container}, nil
+}
+
+// WithBootstrapServers sets the bootstrap servers of the Kafka cluster, e.g. "redpanda:29092",
+// which must be reachable from the network of the container.
+func WithBootstrapServers(servers ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["CONNECT_BOOTSTRAP_SERVERS"] = strings.Join(servers, ",")
+	}
+}
+
+// WithPlugins copies the given paths of the host, either connector jar files or directories
+// containing the jar files of a connector, into the plugin path of the worker.
+func WithPlugins(paths ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		for _, path := range paths {
+			req.Files = append(req.Files, testcontainers.ContainerFile{
+				HostFilePath:      path,
+				ContainerFilePath: pluginsPath + "/" + filepath.Base(path),
+				FileMode:          0o644,
+			})
+		}
+	}
+}
+
+// ConnectorStatus is the status of a connector and its tasks, as returned by the REST API.
+type ConnectorStatus struct {
+	Name      string `json:"name"`
+	Connector struct {
+		State    string `json:"state"`
+		WorkerID string `json:"worker_id"`
+		Trace    string `json:"trace,omitempty"`
+	} `json:"connector"`
+	Tasks []TaskStatus `json:"tasks"`
+	Type  string       `json:"type"`
+}
+
+// TaskStatus is the status of a task of a connector.
+type TaskStatus struct {
+	ID       int    `json:"id"`
+	State    string `json:"state"`
+	WorkerID string `json:"worker_id"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+// URL returns the URL of the REST API of the worker, e.g. "http://localhost:32768".
+func (c *KafkaConnectContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// CreateConnector creates a connector with the given name and configuration,
+// which must include the "connector.class" property.
+func (c *KafkaConnectContainer) CreateConnector(ctx context.Context, name string, config map[string]string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"name":   name,
+		"config": config,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, "/connectors", body, http.StatusCreated)
+	return err
+}
+
+// ConnectorStatus returns the status of the connector with the given name, and of its tasks.
+func (c *KafkaConnectContainer) ConnectorStatus(ctx context.Context, name string) (*ConnectorStatus, error) {
+	body, err := c.do(ctx, http.MethodGet, "/connectors/"+url.PathEscape(name)+"/status", nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var status ConnectorStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode the connector status: %w", err)
+	}
+
+	return &status, nil
+}
+
+// DeleteConnector deletes the connector with the given name.
+func (c *KafkaConnectContainer) DeleteConnector(ctx context.Context, name string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/connectors/"+url.PathEscape(name), nil, http.StatusNoContent)
+	return err
+}
+
+// do sends a request to the REST API, returning the body of the response,
+// or an error if the status code is not the expected one.
+func (c *KafkaConnectContainer) do(ctx context.Context, method string, path string, body []byte, expectedStatus int) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, string(respBody))
+	}
+
+	return respBody, nil
+}
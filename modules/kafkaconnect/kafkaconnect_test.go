@@ -0,0 +1,121 @@
+package kafkaconnect_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/kafkaconnect"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func TestKafkaConnect(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	redpandaContainer, err := redpanda.RunContainer(ctx,
+		network.WithNetwork([]string{"redpanda"}, nw),
+		redpanda.WithListener("redpanda:29092"),
+		redpanda.WithAutoCreateTopics(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := redpandaContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// runKafkaConnect {
+	container, err := kafkaconnect.RunContainer(ctx,
+		network.WithNetwork([]string{"kafka-connect"}, nw),
+		kafkaconnect.WithBootstrapServers("redpanda:29092"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// createConnector {
+	err = container.CreateConnector(ctx, "heartbeats", map[string]string{
+		"connector.class":                     "org.apache.kafka.connect.mirror.MirrorHeartbeatConnector",
+		"source.cluster.alias":                "source",
+		"target.cluster.alias":                "target",
+		"target.cluster.bootstrap.servers":    "redpanda:29092",
+		"heartbeats.topic.replication.factor": "1",
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		// connectorStatus {
+		status, err := container.ConnectorStatus(ctx, "heartbeats")
+		// }
+		if err == nil && status.Connector.State == "RUNNING" {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("the connector is not running: %v, %v", status, err)
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	if err := container.DeleteConnector(ctx, "heartbeats"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := container.ConnectorStatus(ctx, "heartbeats"); err == nil {
+		t.Fatal("expected an error for a deleted connector")
+	}
+}
+
+func TestKafkaConnectWithoutBootstrapServers(t *testing.T) {
+	_, err := kafkaconnect.RunContainer(context.Background())
+	if err == nil {
+		t.Fatal("expected an error without bootstrap servers")
+	}
+}
+
+func TestWithPlugins(t *testing.T) {
+	req := testcontainers.GenericContainerRequest{}
+
+	kafkaconnect.WithPlugins("/tmp/connector.jar", "/tmp/debezium-connector-postgres")(&req)
+
+	if len(req.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(req.Files))
+	}
+
+	expected := []string{
+		"/etc/kafka-connect/plugins/connector.jar",
+		"/etc/kafka-connect/plugins/debezium-connector-postgres",
+	}
+	for i, f := range req.Files {
+		if f.ContainerFilePath != expected[i] {
+			t.Fatalf("expected %s, got %s", expected[i], f.ContainerFilePath)
+		}
+	}
+}
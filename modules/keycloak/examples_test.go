@@ -0,0 +1,37 @@
+package keycloak_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/keycloak"
+)
+
+func ExampleRunContainer() {
+	// runKeycloakContainer {
+	ctx := context.Background()
+
+	keycloakContainer, err := keycloak.RunContainer(ctx, testcontainers.WithImage("quay.io/keycloak/keycloak:23.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := keycloakContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := keycloakContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
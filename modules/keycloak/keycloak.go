@@ -0,0 +1,213 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultAdminUsername = "admin"
+	defaultAdminPassword = "admin"
+
+	defaultHTTPPort  = "8080/tcp"
+	defaultHTTPSPort = "8443/tcp"
+
+	importDir    = "/opt/keycloak/data/import"
+	tlsCertPath  = "/opt/keycloak/conf/tls.crt"
+	tlsKeyPath   = "/opt/keycloak/conf/tls.key"
+	masterRealm  = "master"
+	adminCLIName = "admin-cli"
+)
+
+// KeycloakContainer represents the Keycloak container type used in the module
+type KeycloakContainer struct {
+	testcontainers.Container
+	settings options
+}
+
+// RunContainer creates an instance of the Keycloak container type, running in development mode.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*KeycloakContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "quay.io/keycloak/keycloak:23.0",
+		ExposedPorts: []string{defaultHTTPPort},
+		Env: map[string]string{
+			"KC_HEALTH_ENABLED": "true",
+		},
+		Cmd: []string{"start-dev"},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.AdminUsername == "" || settings.AdminPassword == "" {
+		return nil, errors.New("the admin username and password cannot be empty")
+	}
+
+	genericContainerReq.Env["KEYCLOAK_ADMIN"] = settings.AdminUsername
+	genericContainerReq.Env["KEYCLOAK_ADMIN_PASSWORD"] = settings.AdminPassword
+
+	if settings.ContextPath != "" {
+		genericContainerReq.Env["KC_HTTP_RELATIVE_PATH"] = settings.ContextPath
+	}
+
+	if len(settings.RealmImportFiles) > 0 {
+		for _, realmFile := range settings.RealmImportFiles {
+			genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+				HostFilePath:      realmFile,
+				ContainerFilePath: importDir + "/" + filepath.Base(realmFile),
+				FileMode:          0o644,
+			})
+		}
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--import-realm")
+	}
+
+	if settings.TLSCertFile != "" || settings.TLSKeyFile != "" {
+		if settings.TLSCertFile == "" || settings.TLSKeyFile == "" {
+			return nil, errors.New("both the certificate and the key files are needed to enable TLS")
+		}
+
+		genericContainerReq.Files = append(genericContainerReq.Files,
+			testcontainers.ContainerFile{HostFilePath: settings.TLSCertFile, ContainerFilePath: tlsCertPath, FileMode: 0o644},
+			testcontainers.ContainerFile{HostFilePath: settings.TLSKeyFile, ContainerFilePath: tlsKeyPath, FileMode: 0o644},
+		)
+		genericContainerReq.Env["KC_HTTPS_CERTIFICATE_FILE"] = tlsCertPath
+		genericContainerReq.Env["KC_HTTPS_CERTIFICATE_KEY_FILE"] = tlsKeyPath
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, defaultHTTPSPort)
+	}
+
+	if genericContainerReq.WaitingFor == nil {
+		genericContainerReq.WaitingFor = wait.ForHTTP(settings.ContextPath + "/health/ready").
+			WithPort(defaultHTTPPort).
+			WithStartupTimeout(2 * time.Minute)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeycloakContainer{Container: container, settings: settings}, nil
+}
+
+// BaseURL returns the HTTP URL of Keycloak, including the context path, e.g. http://host:port/auth.
+func (c *KeycloakContainer) BaseURL(ctx context.Context) (string, error) {
+	return c.baseURL(ctx, "http", defaultHTTPPort)
+}
+
+// HTTPSBaseURL returns the HTTPS URL of Keycloak, including the context path, e.g. https://host:port/auth.
+// It returns an error if TLS was not enabled with the WithTLS option.
+func (c *KeycloakContainer) HTTPSBaseURL(ctx context.Context) (string, error) {
+	if c.settings.TLSCertFile == "" {
+		return "", errors.New("TLS is not enabled, please use the WithTLS option")
+	}
+
+	return c.baseURL(ctx, "https", defaultHTTPSPort)
+}
+
+func (c *KeycloakContainer) baseURL(ctx context.Context, scheme string, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s://%s:%s%s", scheme, host, mappedPort.Port(), c.settings.ContextPath), nil
+}
+
+// WellKnownEndpoint returns the URL of the OpenID Connect discovery document of the given realm,
+// which is used by the OIDC clients to discover the rest of the endpoints.
+func (c *KeycloakContainer) WellKnownEndpoint(ctx context.Context, realm string) (string, error) {
+	baseURL, err := c.BaseURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/realms/" + url.PathEscape(realm) + "/.well-known/openid-configuration", nil
+}
+
+// TokenEndpoint returns the URL of the OpenID Connect token endpoint of the given realm.
+func (c *KeycloakContainer) TokenEndpoint(ctx context.Context, realm string) (string, error) {
+	baseURL, err := c.BaseURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/realms/" + url.PathEscape(realm) + "/protocol/openid-connect/token", nil
+}
+
+// AdminUsername returns the name of the admin user of the master realm.
+func (c *KeycloakContainer) AdminUsername() string {
+	return c.settings.AdminUsername
+}
+
+// AdminPassword returns the password of the admin user of the master realm.
+func (c *KeycloakContainer) AdminPassword() string {
+	return c.settings.AdminPassword
+}
+
+// AdminAccessToken returns an access token of the admin user, obtained from the master realm,
+// which can be used as bearer token to call the Admin REST API, e.g. to create realms or users.
+func (c *KeycloakContainer) AdminAccessToken(ctx context.Context) (string, error) {
+	tokenEndpoint, err := c.TokenEndpoint(ctx, masterRealm)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"password"},
+		"client_id":  {adminCLIName},
+		"username":   {c.settings.AdminUsername},
+		"password":   {c.settings.AdminPassword},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request the admin access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to request the admin access token, status code: %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode the admin access token: %w", err)
+	}
+
+	return token.AccessToken, nil
+}
@@ -0,0 +1,199 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestKeycloak(t *testing.T) {
+	ctx := context.Background()
+
+	// withRealmImportFile {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("quay.io/keycloak/keycloak:23.0"),
+		WithAdminCredentials("tc-admin", "tc-password"),
+		WithRealmImportFile(filepath.Join("testdata", "realm.json")),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// wellKnownEndpoint {
+	wellKnown, err := container.WellKnownEndpoint(ctx, "testcontainers")
+	// }
+	require.NoError(t, err)
+
+	resp, err := http.Get(wellKnown)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var discovery struct {
+		Issuer        string `json:"issuer"`
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&discovery))
+	assert.True(t, strings.HasSuffix(discovery.Issuer, "/realms/testcontainers"))
+
+	// tokenEndpoint {
+	tokenEndpoint, err := container.TokenEndpoint(ctx, "testcontainers")
+	// }
+	require.NoError(t, err)
+	assert.Equal(t, discovery.TokenEndpoint, tokenEndpoint)
+
+	tokenResp, err := http.PostForm(tokenEndpoint, url.Values{
+		"grant_type": {"password"},
+		"client_id":  {"test-client"},
+		"username":   {"john"},
+		"password":   {"secret"},
+	})
+	require.NoError(t, err)
+	defer tokenResp.Body.Close()
+	require.Equal(t, http.StatusOK, tokenResp.StatusCode)
+
+	// adminAccessToken {
+	adminToken, err := container.AdminAccessToken(ctx)
+	// }
+	require.NoError(t, err)
+	require.NotEmpty(t, adminToken)
+
+	baseURL, err := container.BaseURL(ctx)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/admin/realms/testcontainers/users?username=john", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+
+	usersResp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer usersResp.Body.Close()
+	require.Equal(t, http.StatusOK, usersResp.StatusCode)
+}
+
+func TestKeycloakWithContextPathAndTLS(t *testing.T) {
+	ctx := context.Background()
+
+	certFile, keyFile := generateCertificate(t)
+
+	// withContextPathAndTLS {
+	container, err := RunContainer(ctx,
+		WithContextPath("/auth"),
+		WithTLS(certFile, keyFile),
+	)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	baseURL, err := container.HTTPSBaseURL(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(baseURL, "https://"))
+	assert.True(t, strings.HasSuffix(baseURL, "/auth"))
+
+	certPEM, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(certPEM))
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+
+	resp, err := client.Get(baseURL + "/realms/master/.well-known/openid-configuration")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	adminToken, err := container.AdminAccessToken(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, adminToken)
+}
+
+func TestKeycloakWithoutTLS(t *testing.T) {
+	c := &KeycloakContainer{settings: defaultOptions()}
+
+	_, err := c.HTTPSBaseURL(context.Background())
+	require.Error(t, err)
+}
+
+func TestWithContextPath(t *testing.T) {
+	tests := []struct {
+		contextPath string
+		expected    string
+	}{
+		{contextPath: "auth", expected: "/auth"},
+		{contextPath: "/auth/", expected: "/auth"},
+		{contextPath: "/", expected: ""},
+		{contextPath: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contextPath, func(t *testing.T) {
+			settings := defaultOptions()
+			WithContextPath(tt.contextPath)(&settings)
+			assert.Equal(t, tt.expected, settings.ContextPath)
+		})
+	}
+}
+
+// generateCertificate creates a self-signed certificate for localhost, returning the paths to the certificate and key files.
+func generateCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0o644))
+
+	return certFile, keyFile
+}
@@ -0,0 +1,77 @@
+package keycloak
+
+import (
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// AdminUsername is the name of the admin user of the master realm.
+	AdminUsername string
+	// AdminPassword is the password of the admin user of the master realm.
+	AdminPassword string
+	// ContextPath is the path under which Keycloak serves its resources, e.g. "/auth".
+	ContextPath string
+	// RealmImportFiles are the paths to the realm files in the host, imported when the container starts.
+	RealmImportFiles []string
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate and key in the host,
+	// used to enable the HTTPS listener.
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+func defaultOptions() options {
+	return options{
+		AdminUsername: defaultAdminUsername,
+		AdminPassword: defaultAdminPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Keycloak container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithAdminCredentials sets the credentials of the admin user of the master realm.
+// If they are not specified, then "admin" will be used for both the username and the password.
+func WithAdminCredentials(username, password string) Option {
+	return func(o *options) {
+		o.AdminUsername = username
+		o.AdminPassword = password
+	}
+}
+
+// WithRealmImportFile imports the realm defined in the given JSON file when the container starts.
+// The file can be exported from an existing Keycloak server. It can be called multiple times.
+func WithRealmImportFile(realmFile string) Option {
+	return func(o *options) {
+		o.RealmImportFiles = append(o.RealmImportFiles, realmFile)
+	}
+}
+
+// WithContextPath sets the path under which Keycloak serves its resources, e.g. "/auth",
+// as the legacy Keycloak distributions did. By default, the resources are served under "/".
+func WithContextPath(contextPath string) Option {
+	return func(o *options) {
+		o.ContextPath = ""
+		if p := strings.Trim(contextPath, "/"); p != "" {
+			o.ContextPath = "/" + p
+		}
+	}
+}
+
+// WithTLS enables the HTTPS listener, using the given PEM encoded certificate and key files.
+// The HTTP listener is still enabled, and it's the one used to check the readiness of the container.
+func WithTLS(certFile, keyFile string) Option {
+	return func(o *options) {
+		o.TLSCertFile = certFile
+		o.TLSKeyFile = keyFile
+	}
+}
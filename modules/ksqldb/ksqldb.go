@@ -0,0 +1,205 @@
+package ksqldb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	restPort = "8088/tcp"
+
+	contentType = "application/vnd.ksql.v1+json"
+)
+
+// KsqlDBContainer represents the ksqlDB container type used in the module
+type KsqlDBContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the ksqlDB container type, running a single server with the
+// replication factor of its internal topics set to 1. The server must join the network of a Kafka or
+// Redpanda cluster, using network.WithNetwork, and the bootstrap servers of the cluster must be set
+// with the WithBootstrapServers option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*KsqlDBContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "confluentinc/cp-ksqldb-server:7.5.0",
+		ExposedPorts: []string{restPort},
+		Env: map[string]string{
+			"KSQL_LISTENERS":                                        "http://0.0.0.0:8088",
+			"KSQL_KSQL_SERVICE_ID":                                  "testcontainers_",
+			"KSQL_KSQL_INTERNAL_TOPIC_REPLICAS":                     "1",
+			"KSQL_KSQL_STREAMS_REPLICATION_FACTOR":                  "1",
+			"KSQL_KSQL_LOGGING_PROCESSING_TOPIC_REPLICATION_FACTOR": "1",
+			"KSQL_KSQL_LOGGING_PROCESSING_STREAM_AUTO_CREATE":       "true",
+			"KSQL_KSQL_LOGGING_PROCESSING_TOPIC_AUTO_CREATE":        "true",
+		},
+		WaitingFor: wait.ForHTTP("/healthcheck").
+			WithPort(restPort).
+			WithResponseMatcher(func(body io.Reader) bool {
+				var health struct {
+					IsHealthy bool `json:"isHealthy"`
+				}
+				if err := json.NewDecoder(body).Decode(&health); err != nil {
+					return false
+				}
+				return health.IsHealthy
+			}).
+			WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env["KSQL_BOOTSTRAP_SERVERS"] == "" {
+		return nil, errors.New("the bootstrap servers are required, use the WithBootstrapServers option")
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KsqlDBContainer{Container: container}, nil
+}
+
+// WithBootstrapServers sets the bootstrap servers of the Kafka cluster, e.g. "redpanda:29092",
+// which must be reachable from the network of the container.
+func WithBootstrapServers(servers ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["KSQL_BOOTSTRAP_SERVERS"] = strings.Join(servers, ",")
+	}
+}
+
+// URL returns the base URL of the REST API, e.g. "http://localhost:32768", to be used as the server
+// address of the ksqlDB clients and of the ksqlDB CLI.
+func (c *KsqlDBContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// ExecuteStatement runs one or more statements, e.g. CREATE STREAM, CREATE TABLE AS SELECT or INSERT INTO,
+// with the given streams properties, e.g. "ksql.streams.auto.offset.reset" set to "earliest". It returns
+// the entities of the response, one for each statement, or an error describing the failed statement.
+func (c *KsqlDBContainer) ExecuteStatement(ctx context.Context, statement string, properties map[string]string) ([]json.RawMessage, error) {
+	respBody, err := c.post(ctx, "/ksql", statement, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []json.RawMessage
+	if err := json.Unmarshal(respBody, &entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// Query runs a pull query, returning the columns of each row of the result. Push queries, which never
+// complete, are not supported.
+func (c *KsqlDBContainer) Query(ctx context.Context, query string, properties map[string]string) ([][]interface{}, error) {
+	respBody, err := c.post(ctx, "/query", query, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first element is the header of the result, followed by the rows
+	var elements []struct {
+		Row *struct {
+			Columns []interface{} `json:"columns"`
+		} `json:"row"`
+		ErrorMessage *struct {
+			Message string `json:"message"`
+		} `json:"errorMessage"`
+	}
+	if err := json.Unmarshal(respBody, &elements); err != nil {
+		return nil, err
+	}
+
+	rows := [][]interface{}{}
+	for _, element := range elements {
+		if element.ErrorMessage != nil {
+			return nil, errors.New(element.ErrorMessage.Message)
+		}
+
+		if element.Row != nil {
+			rows = append(rows, element.Row.Columns)
+		}
+	}
+
+	return rows, nil
+}
+
+func (c *KsqlDBContainer) post(ctx context.Context, path string, ksql string, properties map[string]string) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"ksql":              ksql,
+		"streamsProperties": properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var statementError struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(respBody, &statementError); err == nil && statementError.Message != "" {
+			return nil, fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, path, statementError.Message)
+		}
+
+		return nil, fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, path, respBody)
+	}
+
+	return respBody, nil
+}
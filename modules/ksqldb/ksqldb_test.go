@@ -0,0 +1,97 @@
+package ksqldb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/ksqldb"
+	"github.com/testcontainers/testcontainers-go/modules/redpanda"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func TestKsqlDB(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	redpandaContainer, err := redpanda.RunContainer(ctx,
+		network.WithNetwork([]string{"redpanda"}, nw),
+		redpanda.WithListener("redpanda:29092"),
+		redpanda.WithAutoCreateTopics(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := redpandaContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// runKsqlDB {
+	container, err := ksqldb.RunContainer(ctx,
+		network.WithNetwork([]string{"ksqldb"}, nw),
+		ksqldb.WithBootstrapServers("redpanda:29092"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// executeStatement {
+	_, err = container.ExecuteStatement(ctx, `
+CREATE STREAM clicks (country VARCHAR, clicks INT) WITH (kafka_topic='clicks', value_format='JSON', partitions=1);
+CREATE TABLE clicks_by_country AS SELECT country, SUM(clicks) AS total FROM clicks GROUP BY country EMIT CHANGES;
+INSERT INTO clicks (country, clicks) VALUES ('es', 3);
+INSERT INTO clicks (country, clicks) VALUES ('fr', 5);
+INSERT INTO clicks (country, clicks) VALUES ('es', 4);
+`, map[string]string{"ksql.streams.auto.offset.reset": "earliest"})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the table is updated asynchronously by the persistent query
+	deadline := time.Now().Add(time.Minute)
+	for {
+		// query {
+		rows, err := container.Query(ctx, "SELECT total FROM clicks_by_country WHERE country = 'es';", nil)
+		// }
+		if err == nil && len(rows) == 1 && rows[0][0] == 7.0 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 7 clicks for es, got %v: %v", rows, err)
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	if _, err := container.ExecuteStatement(ctx, "DROP STREAM missing;", nil); err == nil {
+		t.Fatal("expected an error for a missing stream")
+	}
+}
+
+func TestKsqlDBWithoutBootstrapServers(t *testing.T) {
+	_, err := ksqldb.RunContainer(context.Background())
+	if err == nil {
+		t.Fatal("expected an error without bootstrap servers")
+	}
+}
@@ -0,0 +1,89 @@
+package localstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+const (
+	// DefaultRegion is the region used by the AWS config returned by the AWSConfig method,
+	// unless a different one is passed as an option.
+	DefaultRegion = "us-east-1"
+	// DefaultAccessKeyID is the access key ID used by the AWS config returned by the AWSConfig method.
+	// LocalStack accepts any value, using it as the account ID if it's a 12 digits number.
+	DefaultAccessKeyID = "test"
+	// DefaultSecretAccessKey is the secret access key used by the AWS config returned by the AWSConfig method.
+	DefaultSecretAccessKey = "test"
+)
+
+// WithServices sets the AWS services to be started by LocalStack, e.g. "s3", "sqs" or "dynamodb",
+// using the SERVICES environment variable. By default, LocalStack starts the services lazily
+// on their first request, so this option is useful to reduce the startup time of the services
+// under test, or to fail fast when a service is not available.
+func WithServices(services ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		if req.Env == nil {
+			req.Env = map[string]string{}
+		}
+
+		req.Env["SERVICES"] = strings.Join(services, ",")
+	}
+}
+
+// Endpoint returns the URL of the LocalStack edge port, which serves all the AWS services,
+// using the host and the mapped port of the container. LocalStack in legacy mode (< 0.11.0)
+// serves each service on its own port, so an error is returned for those versions.
+func (l *LocalStackContainer) Endpoint(ctx context.Context) (string, error) {
+	if dc, ok := l.Container.(*testcontainers.DockerContainer); ok && isLegacyMode(dc.Image) {
+		return "", fmt.Errorf("version=%s. LocalStack in legacy mode serves each AWS service on its own port, so there is no single endpoint. Please use a version >= 0.11.0", dc.Image)
+	}
+
+	mappedPort, err := l.MappedPort(ctx, nat.Port(fmt.Sprintf("%d/tcp", defaultPort)))
+	if err != nil {
+		return "", err
+	}
+
+	host, err := l.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%d", host, mappedPort.Int()), nil
+}
+
+// AWSConfig returns a configuration for the clients of the AWS SDK for Go v2, which resolves the
+// endpoints of all the services to the LocalStack container, using static credentials and the
+// default region. Like Endpoint, it returns an error for LocalStack in legacy mode. The options are applied after the defaults, so they can override them, e.g.
+// config.WithRegion("eu-west-1").
+func (l *LocalStackContainer) AWSConfig(ctx context.Context, optFns ...func(*config.LoadOptions) error) (aws.Config, error) {
+	endpoint, err := l.Endpoint(ctx)
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	resolver := aws.EndpointResolverWithOptionsFunc(
+		func(service, region string, opts ...interface{}) (aws.Endpoint, error) {
+			return aws.Endpoint{
+				PartitionID:       "aws",
+				URL:               endpoint,
+				SigningRegion:     region,
+				HostnameImmutable: true,
+			}, nil
+		})
+
+	opts := []func(*config.LoadOptions) error{
+		config.WithRegion(DefaultRegion),
+		config.WithEndpointResolverWithOptions(resolver),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(DefaultAccessKeyID, DefaultSecretAccessKey, "")),
+	}
+
+	return config.LoadDefaultConfig(ctx, append(opts, optFns...)...)
+}
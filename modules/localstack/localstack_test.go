@@ -107,6 +107,27 @@ func TestIsLegacyMode(t *testing.T) {
 	}
 }
 
+func TestEndpoint_legacyMode(t *testing.T) {
+	// the image is checked before resolving the host and the port
+	container := &LocalStackContainer{
+		Container: &testcontainers.DockerContainer{Image: "localstack/localstack:0.10.0"},
+	}
+
+	_, err := container.Endpoint(context.Background())
+	require.ErrorContains(t, err, "legacy mode")
+
+	_, err = container.AWSConfig(context.Background())
+	require.ErrorContains(t, err, "legacy mode")
+}
+
+func TestWithServices(t *testing.T) {
+	req := generateContainerRequest()
+
+	WithServices("s3", "sqs", "dynamodb")(&req.GenericContainerRequest)
+
+	assert.Equal(t, "s3,sqs,dynamodb", req.Env["SERVICES"])
+}
+
 func TestRunContainer(t *testing.T) {
 	tests := []struct {
 		version string
@@ -128,3 +128,38 @@ func TestS3(t *testing.T) {
 		})
 	})
 }
+
+func TestS3WithAWSConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// withServices {
+	container, err := localstack.RunContainer(ctx, localstack.WithServices("s3"))
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// awsConfig {
+	awsCfg, err := container.AWSConfig(ctx)
+	require.NoError(t, err)
+
+	s3Client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	// }
+
+	bucketName := "localstack-config-bucket"
+	_, err = s3Client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	require.NoError(t, err)
+
+	output, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	require.NoError(t, err)
+	require.Len(t, output.Buckets, 1)
+	assert.Equal(t, bucketName, *output.Buckets[0].Name)
+}
@@ -0,0 +1,37 @@
+package loki_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/loki"
+)
+
+func ExampleRunContainer() {
+	// runLokiContainer {
+	ctx := context.Background()
+
+	lokiContainer, err := loki.RunContainer(ctx, testcontainers.WithImage("grafana/loki:2.9.4"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := lokiContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := lokiContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,228 @@
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "3100/tcp"
+
+// LokiContainer represents the Loki container type used in the module
+type LokiContainer struct {
+	testcontainers.Container
+}
+
+// Entry is a log line of a stream.
+type Entry struct {
+	Timestamp time.Time
+	Line      string
+}
+
+// Stream is a stream of log lines sharing the same labels.
+type Stream struct {
+	Labels  map[string]string
+	Entries []Entry
+}
+
+// RunContainer creates an instance of the Loki container type, running a single binary with the
+// default configuration of the image, which stores the logs in the filesystem of the container.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*LokiContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "grafana/loki:2.9.4",
+		ExposedPorts: []string{httpPort},
+		// the ingester is ready 15 seconds after joining the ring
+		WaitingFor: wait.ForHTTP("/ready").WithPort(httpPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LokiContainer{Container: container}, nil
+}
+
+// URL returns the base URL of Loki, e.g. "http://localhost:32768", to be used as a Loki data source
+// or by the Loki clients.
+func (c *LokiContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// PushURL returns the URL of the push endpoint, e.g. "http://localhost:32768/loki/api/v1/push",
+// to be used by the log shippers, e.g. Promtail, Fluent Bit or the OpenTelemetry Collector.
+func (c *LokiContainer) PushURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/loki/api/v1/push", nil
+}
+
+// Push pushes the given log lines, timestamped with the current time, to a stream with the given labels.
+func (c *LokiContainer) Push(ctx context.Context, labels map[string]string, lines ...string) error {
+	now := time.Now()
+	values := make([][2]string, 0, len(lines))
+	for i, line := range lines {
+		// the lines of a stream are ordered by their timestamps
+		ts := now.Add(time.Duration(i) * time.Nanosecond)
+		values = append(values, [2]string{strconv.FormatInt(ts.UnixNano(), 10), line})
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"streams": []interface{}{
+			map[string]interface{}{
+				"stream": labels,
+				"values": values,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	pushURL, err := c.PushURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, pushURL, bytes.NewReader(body), http.StatusNoContent)
+	return err
+}
+
+// QueryRange runs the given LogQL log query between start and end, returning at most limit lines,
+// the most recent first. The logs are ingested asynchronously, so the tests usually retry until
+// the expected lines are returned.
+func (c *LokiContainer) QueryRange(ctx context.Context, query string, start time.Time, end time.Time, limit int) ([]Stream, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(limit))
+
+	body, err := c.do(ctx, http.MethodGet, baseURL+"/loki/api/v1/query_range?"+params.Encode(), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Data.ResultType != "streams" {
+		return nil, fmt.Errorf("the query %s is not a log query, its result type is %s", query, response.Data.ResultType)
+	}
+
+	var results []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+	if err := json.Unmarshal(response.Data.Result, &results); err != nil {
+		return nil, err
+	}
+
+	streams := make([]Stream, 0, len(results))
+	for _, result := range results {
+		stream := Stream{Labels: result.Stream, Entries: make([]Entry, 0, len(result.Values))}
+		for _, value := range result.Values {
+			ns, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			stream.Entries = append(stream.Entries, Entry{Timestamp: time.Unix(0, ns), Line: value[1]})
+		}
+		streams = append(streams, stream)
+	}
+
+	return streams, nil
+}
+
+// LabelValues returns the values of the given label, e.g. the names of the services for the "service" label.
+func (c *LokiContainer) LabelValues(ctx context.Context, label string) ([]string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(ctx, http.MethodGet, baseURL+"/loki/api/v1/label/"+url.PathEscape(label)+"/values", nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []string `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+func (c *LokiContainer) do(ctx context.Context, method string, url string, body io.Reader, expectedStatus int) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, url, respBody)
+	}
+
+	return respBody, nil
+}
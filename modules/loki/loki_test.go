@@ -0,0 +1,98 @@
+package loki_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/loki"
+)
+
+func TestLoki(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := loki.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	start := time.Now().Add(-time.Minute)
+
+	// push {
+	err = container.Push(ctx, map[string]string{"service": "checkout"}, "order placed", "order paid")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the log shippers use the push endpoint
+	// pushURL {
+	pushURL, err := container.PushURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := fmt.Sprintf(`{"streams": [{"stream": {"service": "payment"}, "values": [["%d", "payment accepted"]]}]}`, time.Now().UnixNano())
+	resp, err := http.Post(pushURL, "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status code %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+
+	// queryRange {
+	var streams []loki.Stream
+	deadline := time.Now().Add(30 * time.Second)
+	for len(streams) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the logs to be ingested")
+		}
+
+		streams, err = container.QueryRange(ctx, `{service="checkout"}`, start, time.Now().Add(time.Minute), 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+	// }
+
+	if len(streams) != 1 || len(streams[0].Entries) != 2 {
+		t.Fatalf("expected a single stream with two lines, got %v", streams)
+	}
+
+	// the most recent lines are returned first
+	if streams[0].Entries[0].Line != "order paid" || streams[0].Entries[1].Line != "order placed" {
+		t.Fatalf("unexpected lines %v", streams[0].Entries)
+	}
+
+	// labelValues {
+	services, err := container.LabelValues(ctx, "service")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(services) != 2 || services[0] != "checkout" || services[1] != "payment" {
+		t.Fatalf("expected the checkout and payment services, got %v", services)
+	}
+
+	_, err = container.QueryRange(ctx, `count_over_time({service="checkout"}[1m])`, start, time.Now(), 100)
+	if err == nil {
+		t.Fatal("expected an error for a metric query")
+	}
+}
@@ -0,0 +1,37 @@
+package mailpit_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mailpit"
+)
+
+func ExampleRunContainer() {
+	// runMailpitContainer {
+	ctx := context.Background()
+
+	mailpitContainer, err := mailpit.RunContainer(ctx, testcontainers.WithImage("axllent/mailpit:v1.13.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := mailpitContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := mailpitContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
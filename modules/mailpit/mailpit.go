@@ -0,0 +1,92 @@
+package mailpit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	smtpPort = "1025/tcp"
+	httpPort = "8025/tcp"
+)
+
+// MailpitContainer represents the Mailpit container type used in the module
+type MailpitContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Mailpit container type, capturing the emails sent to its
+// SMTP server, which are read back with the HTTP API.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*MailpitContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "axllent/mailpit:v1.13.0",
+		ExposedPorts: []string{smtpPort, httpPort},
+		WaitingFor: wait.ForAll(
+			wait.ForListeningPort(smtpPort),
+			wait.ForHTTP("/api/v1/info").WithPort(httpPort),
+		).WithDeadline(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.SMTPAuthAcceptAny {
+		if genericContainerReq.Env == nil {
+			genericContainerReq.Env = map[string]string{}
+		}
+		genericContainerReq.Env["MP_SMTP_AUTH_ACCEPT_ANY"] = "true"
+		genericContainerReq.Env["MP_SMTP_AUTH_ALLOW_INSECURE"] = "true"
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MailpitContainer{Container: container}, nil
+}
+
+// SMTPAddress returns the "host:port" address of the SMTP server, to be used by the mail-sending code.
+func (c *MailpitContainer) SMTPAddress(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, smtpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// URL returns the base URL of the Mailpit UI and HTTP API, e.g. "http://localhost:32768".
+func (c *MailpitContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
@@ -0,0 +1,108 @@
+package mailpit_test
+
+import (
+	"context"
+	"net"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/mailpit"
+)
+
+func TestMailpit(t *testing.T) {
+	ctx := context.Background()
+
+	// withSMTPAuthAcceptAny {
+	container, err := mailpit.RunContainer(ctx, mailpit.WithSMTPAuthAcceptAny())
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// smtpAddress {
+	smtpAddress, err := container.SMTPAddress(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, _, err := net.SplitHostPort(smtpAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := "From: Shop <shop@example.com>\r\n" +
+		"To: Alice <alice@example.com>\r\n" +
+		"Subject: Welcome to the shop\r\n" +
+		"\r\n" +
+		"Your account is ready.\r\n"
+
+	// any credentials are accepted
+	auth := smtp.PlainAuth("", "user", "password", host)
+	if err := smtp.SendMail(smtpAddress, auth, "shop@example.com", []string{"alice@example.com"}, []byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+
+	// waitForMessage {
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	message, err := container.WaitForMessage(waitCtx, `to:"alice@example.com" subject:"Welcome"`)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.From.Address != "shop@example.com" || len(message.To) != 1 || message.To[0].Name != "Alice" {
+		t.Fatalf("unexpected addresses %v %v", message.From, message.To)
+	}
+
+	if !strings.Contains(message.Text, "Your account is ready.") {
+		t.Fatalf("unexpected text %q", message.Text)
+	}
+
+	// messages {
+	messages, err := container.Messages(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 1 || messages[0].Subject != "Welcome to the shop" {
+		t.Fatalf("expected a single message, got %v", messages)
+	}
+
+	found, err := container.Search(ctx, `to:"bob@example.com"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(found) != 0 {
+		t.Fatalf("expected no message for bob, got %v", found)
+	}
+
+	// deleteAllMessages {
+	err = container.DeleteAllMessages(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages, err = container.Messages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 0 {
+		t.Fatalf("expected no message, got %v", messages)
+	}
+}
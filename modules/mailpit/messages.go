@@ -0,0 +1,157 @@
+package mailpit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Address is the name and the email address of a sender or a recipient.
+type Address struct {
+	Name    string `json:"Name"`
+	Address string `json:"Address"`
+}
+
+// MessageSummary is the summary of a captured email, as listed by the HTTP API.
+type MessageSummary struct {
+	ID          string    `json:"ID"`
+	MessageID   string    `json:"MessageID"`
+	From        Address   `json:"From"`
+	To          []Address `json:"To"`
+	Cc          []Address `json:"Cc"`
+	Bcc         []Address `json:"Bcc"`
+	Subject     string    `json:"Subject"`
+	Created     time.Time `json:"Created"`
+	Tags        []string  `json:"Tags"`
+	Size        int       `json:"Size"`
+	Attachments int       `json:"Attachments"`
+	Snippet     string    `json:"Snippet"`
+}
+
+// Message is a captured email, with its bodies and the metadata of its attachments.
+type Message struct {
+	ID          string       `json:"ID"`
+	MessageID   string       `json:"MessageID"`
+	From        Address      `json:"From"`
+	To          []Address    `json:"To"`
+	Cc          []Address    `json:"Cc"`
+	Bcc         []Address    `json:"Bcc"`
+	ReplyTo     []Address    `json:"ReplyTo"`
+	Subject     string       `json:"Subject"`
+	Date        time.Time    `json:"Date"`
+	Tags        []string     `json:"Tags"`
+	Text        string       `json:"Text"`
+	HTML        string       `json:"HTML"`
+	Size        int          `json:"Size"`
+	Attachments []Attachment `json:"Attachments"`
+}
+
+// Attachment is the metadata of an attachment of a captured email.
+type Attachment struct {
+	PartID      string `json:"PartID"`
+	FileName    string `json:"FileName"`
+	ContentType string `json:"ContentType"`
+	Size        int    `json:"Size"`
+}
+
+// Messages returns the summaries of the captured emails, the most recent first.
+func (c *MailpitContainer) Messages(ctx context.Context) ([]MessageSummary, error) {
+	return c.listMessages(ctx, "/api/v1/messages")
+}
+
+// Search returns the summaries of the captured emails matching the given query, the most recent first,
+// with the search syntax of Mailpit, e.g. `to:"alice@example.com" subject:"Welcome"`.
+func (c *MailpitContainer) Search(ctx context.Context, query string) ([]MessageSummary, error) {
+	return c.listMessages(ctx, "/api/v1/search?query="+url.QueryEscape(query))
+}
+
+// Message returns the captured email with the given ID, or "latest" for the most recent one.
+func (c *MailpitContainer) Message(ctx context.Context, id string) (Message, error) {
+	var message Message
+	body, err := c.do(ctx, http.MethodGet, "/api/v1/message/"+url.PathEscape(id))
+	if err != nil {
+		return message, err
+	}
+
+	err = json.Unmarshal(body, &message)
+	return message, err
+}
+
+// WaitForMessage waits until an email matching the given query is captured, returning it.
+// The context should have a deadline, as it's the only way to stop waiting.
+func (c *MailpitContainer) WaitForMessage(ctx context.Context, query string) (Message, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		summaries, err := c.Search(ctx, query)
+		if err != nil {
+			return Message{}, err
+		}
+
+		if len(summaries) > 0 {
+			return c.Message(ctx, summaries[0].ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return Message{}, fmt.Errorf("no message matching %q: %w", query, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteAllMessages deletes all the captured emails, e.g. between two tests.
+func (c *MailpitContainer) DeleteAllMessages(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodDelete, "/api/v1/messages")
+	return err
+}
+
+func (c *MailpitContainer) listMessages(ctx context.Context, path string) ([]MessageSummary, error) {
+	body, err := c.do(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Messages []MessageSummary `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Messages, nil
+}
+
+func (c *MailpitContainer) do(ctx context.Context, method string, path string) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, body)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,33 @@
+package mailpit
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// SMTPAuthAcceptAny makes the SMTP server accept any credentials.
+	SMTPAuthAcceptAny bool
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Mailpit container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithSMTPAuthAcceptAny makes the SMTP server accept any credentials, without TLS, for the mail-sending code
+// which always authenticates. By default, the SMTP server rejects the authentication.
+func WithSMTPAuthAcceptAny() Option {
+	return func(o *options) {
+		o.SMTPAuthAcceptAny = true
+	}
+}
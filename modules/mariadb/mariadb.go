@@ -3,6 +3,7 @@ package mariadb
 import (
 	"context"
 	"fmt"
+	"net"
 	"path/filepath"
 	"strings"
 
@@ -66,24 +67,31 @@ func withMySQLEnvVars() testcontainers.CustomizeRequestOption {
 	}
 }
 
+// WithUsername sets the initial username to be created when the container starts.
+// If the username is "root", no extra user is created, and the password is set for the root user.
 func WithUsername(username string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MARIADB_USER"] = username
 	}
 }
 
+// WithPassword sets the password of the user created when the container starts.
+// An empty password can only be used with the root user.
 func WithPassword(password string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MARIADB_PASSWORD"] = password
 	}
 }
 
+// WithDatabase sets the name of the database to be created when the container starts.
 func WithDatabase(database string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MARIADB_DATABASE"] = database
 	}
 }
 
+// WithConfigFile copies the given my.cnf file into the /etc/mysql/conf.d directory of the container,
+// so its settings are applied when the server starts.
 func WithConfigFile(configFile string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		cf := testcontainers.ContainerFile{
@@ -95,6 +103,8 @@ func WithConfigFile(configFile string) testcontainers.CustomizeRequestOption {
 	}
 }
 
+// WithScripts copies the given *.sql, *.sql.gz or *.sh scripts into the /docker-entrypoint-initdb.d
+// directory of the container, which are executed in alphabetical order when the database is initialised.
 func WithScripts(scripts ...string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		var initScripts []testcontainers.ContainerFile
@@ -159,6 +169,10 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &MariaDBContainer{container, username, password, database}, nil
 }
 
+// ConnectionString returns the DSN to connect to the MariaDB container, in the format expected by
+// the github.com/go-sql-driver/mysql driver, using the credentials and database of the container
+// and the host and mapped port of 3306/tcp. The extra arguments are appended as query parameters,
+// e.g. "tls=skip-verify" or "parseTime=true".
 func (c *MariaDBContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
 	containerPort, err := c.MappedPort(ctx, "3306/tcp")
 	if err != nil {
@@ -178,6 +192,6 @@ func (c *MariaDBContainer) ConnectionString(ctx context.Context, args ...string)
 		extraArgs = "?" + extraArgs
 	}
 
-	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s%s", c.username, c.password, host, containerPort.Port(), c.database, extraArgs)
+	connectionString := fmt.Sprintf("%s:%s@tcp(%s)/%s%s", c.username, c.password, net.JoinHostPort(host, containerPort.Port()), c.database, extraArgs)
 	return connectionString, nil
 }
@@ -3,9 +3,11 @@ package mariadb
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/docker/go-connections/nat"
 	// Import mysql into the scope of this package (required)
 	_ "github.com/go-sql-driver/mysql"
 
@@ -227,3 +229,44 @@ func assertDataCanBeFetched(t *testing.T, ctx context.Context, container *MariaD
 		t.Fatal("The expected record was not found in the database.")
 	}
 }
+
+// hostContainer is a container that only resolves its host and the mapped port
+type hostContainer struct {
+	testcontainers.Container
+	host string
+}
+
+func (c hostContainer) Host(_ context.Context) (string, error) {
+	return c.host, nil
+}
+
+func (c hostContainer) MappedPort(_ context.Context, _ nat.Port) (nat.Port, error) {
+	return "49153/tcp", nil
+}
+
+func TestMariaDBConnectionStringHostPort(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{host: "localhost", expected: "%s:%s@tcp(localhost:49153)/%s?tls=skip-verify"},
+		{host: "127.0.0.1", expected: "%s:%s@tcp(127.0.0.1:49153)/%s?tls=skip-verify"},
+		{host: "::1", expected: "%s:%s@tcp([::1]:49153)/%s?tls=skip-verify"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			container := &MariaDBContainer{Container: hostContainer{host: test.host}, username: "foo", password: "bar", database: "baz"}
+
+			connectionString, err := container.ConnectionString(context.Background(), "tls=skip-verify")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := fmt.Sprintf(test.expected, "foo", "bar", "baz")
+			if connectionString != expected {
+				t.Fatalf("expected connection string %q, got %q", expected, connectionString)
+			}
+		})
+	}
+}
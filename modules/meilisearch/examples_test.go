@@ -0,0 +1,37 @@
+package meilisearch_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/meilisearch"
+)
+
+func ExampleRunContainer() {
+	// runMeilisearchContainer {
+	ctx := context.Background()
+
+	meilisearchContainer, err := meilisearch.RunContainer(ctx, testcontainers.WithImage("getmeili/meilisearch:v1.6.2"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := meilisearchContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := meilisearchContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,215 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "7700/tcp"
+
+	dumpsPath      = "/meili_data/dumps"
+	importDumpPath = "/meili_data/import.dump"
+)
+
+// MeilisearchContainer represents the Meilisearch container type used in the module
+type MeilisearchContainer struct {
+	testcontainers.Container
+	masterKey string
+}
+
+// RunContainer creates an instance of the Meilisearch container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*MeilisearchContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "getmeili/meilisearch:v1.6.2",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"MEILI_NO_ANALYTICS": "true",
+		},
+		WaitingFor: wait.ForHTTP("/health").WithPort(httpPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.MasterKey != "" {
+		genericContainerReq.Env["MEILI_MASTER_KEY"] = settings.MasterKey
+	}
+
+	if settings.DumpFile != "" {
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      settings.DumpFile,
+			ContainerFilePath: importDumpPath,
+			FileMode:          0o644,
+		})
+		// the dump is imported before the HTTP server starts
+		genericContainerReq.Env["MEILI_IMPORT_DUMP"] = importDumpPath
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MeilisearchContainer{Container: container, masterKey: settings.MasterKey}, nil
+}
+
+// Address returns the URL of the HTTP API of Meilisearch, e.g. "http://localhost:32768",
+// to be used as the host of the Meilisearch clients.
+func (c *MeilisearchContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// MasterKey returns the master key set with the WithMasterKey option, or an empty string.
+func (c *MeilisearchContainer) MasterKey() string {
+	return c.masterKey
+}
+
+// ExportDump creates a dump of the instance, including its indexes, documents, settings and keys,
+// and saves it in the given path of the host, to be imported by another container with the WithDumpImport option.
+func (c *MeilisearchContainer) ExportDump(ctx context.Context, hostPath string) error {
+	respBody, err := c.do(ctx, http.MethodPost, "/dumps")
+	if err != nil {
+		return fmt.Errorf("failed to create the dump: %w", err)
+	}
+
+	var enqueued struct {
+		TaskUID int `json:"taskUid"`
+	}
+	if err := json.Unmarshal(respBody, &enqueued); err != nil {
+		return fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	dumpUID, err := c.waitForDump(ctx, enqueued.TaskUID)
+	if err != nil {
+		return err
+	}
+
+	reader, err := c.CopyFileFromContainer(ctx, dumpsPath+"/"+dumpUID+".dump")
+	if err != nil {
+		return fmt.Errorf("failed to copy the dump: %w", err)
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(hostPath), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(hostPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return fmt.Errorf("failed to save the dump: %w", err)
+	}
+
+	return nil
+}
+
+// waitForDump polls the dump creation task until it succeeds, returning the UID of the dump.
+func (c *MeilisearchContainer) waitForDump(ctx context.Context, taskUID int) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	for {
+		respBody, err := c.do(ctx, http.MethodGet, "/tasks/"+strconv.Itoa(taskUID))
+		if err != nil {
+			return "", err
+		}
+
+		var task struct {
+			Status  string `json:"status"`
+			Details struct {
+				DumpUID string `json:"dumpUid"`
+			} `json:"details"`
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(respBody, &task); err != nil {
+			return "", fmt.Errorf("failed to decode the response: %w", err)
+		}
+
+		switch task.Status {
+		case "succeeded":
+			return task.Details.DumpUID, nil
+		case "failed", "canceled":
+			if task.Error != nil {
+				return "", fmt.Errorf("the dump task %s: %s", task.Status, task.Error.Message)
+			}
+			return "", fmt.Errorf("the dump task %s", task.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("the dump task is not finished: %w", ctx.Err())
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+// do sends a request to the HTTP API, authenticated with the master key if set, returning the body
+// of the response, or an error if the status code is not 2xx.
+func (c *MeilisearchContainer) do(ctx context.Context, method string, path string) ([]byte, error) {
+	baseURL, err := c.Address(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.masterKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.masterKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, string(respBody))
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,189 @@
+package meilisearch_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/meilisearch"
+)
+
+const masterKey = "a-master-key-of-at-least-16-bytes"
+
+func TestMeilisearch(t *testing.T) {
+	ctx := context.Background()
+
+	// withMasterKey {
+	container, err := meilisearch.RunContainer(ctx, meilisearch.WithMasterKey(masterKey))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// address {
+	address, err := container.Address(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(address + "/indexes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status code %d without the master key, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	var indexes struct {
+		Total int `json:"total"`
+	}
+	request(t, address, http.MethodGet, "/indexes", nil, &indexes)
+
+	if indexes.Total != 0 {
+		t.Fatalf("expected no indexes, got %d", indexes.Total)
+	}
+}
+
+func TestMeilisearchDump(t *testing.T) {
+	ctx := context.Background()
+
+	source, err := meilisearch.RunContainer(ctx, meilisearch.WithMasterKey(masterKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := source.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	sourceAddress, err := source.Address(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var task struct {
+		TaskUID int `json:"taskUid"`
+	}
+	request(t, sourceAddress, http.MethodPost, "/indexes/movies/documents", []map[string]interface{}{
+		{"id": 1, "title": "Carol"},
+		{"id": 2, "title": "Wonder Woman"},
+	}, &task)
+	waitForTask(t, sourceAddress, task.TaskUID)
+
+	// exportDump {
+	dumpPath := filepath.Join(t.TempDir(), "movies.dump")
+	err = source.ExportDump(ctx, dumpPath)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// withDumpImport {
+	container, err := meilisearch.RunContainer(ctx,
+		meilisearch.WithMasterKey(masterKey),
+		meilisearch.WithDumpImport(dumpPath),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	address, err := container.Address(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var search struct {
+		Hits []struct {
+			Title string `json:"title"`
+		} `json:"hits"`
+	}
+	request(t, address, http.MethodPost, "/indexes/movies/search", map[string]string{"q": "wonder"}, &search)
+
+	if len(search.Hits) != 1 || search.Hits[0].Title != "Wonder Woman" {
+		t.Fatalf("expected Wonder Woman, got %v", search.Hits)
+	}
+}
+
+// request sends a request authenticated with the master key, decoding the JSON response into v.
+func request(t *testing.T, address string, method string, path string, body interface{}, v interface{}) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, address+path, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+masterKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(resp.Body)
+		t.Fatalf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, string(data))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitForTask waits for the task with the given UID to succeed.
+func waitForTask(t *testing.T, address string, taskUID int) {
+	t.Helper()
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		var task struct {
+			Status string `json:"status"`
+		}
+		request(t, address, http.MethodGet, "/tasks/"+strconv.Itoa(taskUID), nil, &task)
+
+		if task.Status == "succeeded" {
+			return
+		}
+
+		if task.Status == "failed" || time.Now().After(deadline) {
+			t.Fatalf("expected the task %d to succeed, got %s", taskUID, task.Status)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,44 @@
+package meilisearch
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// MasterKey is the master key protecting the API.
+	MasterKey string
+	// DumpFile is the path of a dump file in the host, imported when the container starts.
+	DumpFile string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Meilisearch container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithMasterKey sets the master key protecting the API, which must be sent as a bearer token.
+// The API is not protected by default.
+func WithMasterKey(masterKey string) Option {
+	return func(o *options) {
+		o.MasterKey = masterKey
+	}
+}
+
+// WithDumpImport imports the dump file in the given path of the host when the container starts,
+// pre-seeding its indexes, documents, settings and keys. The dump can be created with the
+// ExportDump method of another container, or with the /dumps endpoint of any instance of a compatible version.
+func WithDumpImport(dumpPath string) Option {
+	return func(o *options) {
+		o.DumpFile = dumpPath
+	}
+}
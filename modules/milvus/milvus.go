@@ -0,0 +1,102 @@
+package milvus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	grpcPort    = "19530/tcp"
+	metricsPort = "9091/tcp"
+
+	embedEtcdConfigPath = "/milvus/configs/embedEtcd.yaml"
+)
+
+// embedEtcdConfig is the configuration of the etcd server embedded in Milvus.
+const embedEtcdConfig = `listen-client-urls: http://0.0.0.0:2379
+advertise-client-urls: http://0.0.0.0:2379
+quota-backend-bytes: 4294967296
+auto-compaction-mode: revision
+auto-compaction-retention: '1000'
+`
+
+// MilvusContainer represents the Milvus container type used in the module
+type MilvusContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Milvus container type, running Milvus in standalone mode,
+// with an embedded etcd server and the local storage instead of MinIO, so no other containers are needed.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*MilvusContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "milvusdb/milvus:v2.3.5",
+		ExposedPorts: []string{grpcPort, metricsPort},
+		Env: map[string]string{
+			"ETCD_USE_EMBED":     "true",
+			"ETCD_DATA_DIR":      "/var/lib/milvus/etcd",
+			"ETCD_CONFIG_PATH":   embedEtcdConfigPath,
+			"COMMON_STORAGETYPE": "local",
+		},
+		Cmd: []string{"milvus", "run", "standalone"},
+		LifecycleHooks: []testcontainers.ContainerLifecycleHooks{
+			{
+				PostCreates: []testcontainers.ContainerHook{
+					func(ctx context.Context, c testcontainers.Container) error {
+						return c.CopyToContainer(ctx, []byte(embedEtcdConfig), embedEtcdConfigPath, 0o644)
+					},
+				},
+			},
+		},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/healthz").WithPort(metricsPort),
+			wait.ForListeningPort(grpcPort),
+		).WithStartupTimeoutDefault(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MilvusContainer{Container: container}, nil
+}
+
+// ConnectionString returns the "host:port" address of the gRPC endpoint, to be used as the
+// address of the Milvus SDK clients. The RESTful API is served on the same port.
+func (c *MilvusContainer) ConnectionString(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, grpcPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// RESTURL returns the base URL of the RESTful API, e.g. "http://localhost:32768".
+func (c *MilvusContainer) RESTURL(ctx context.Context) (string, error) {
+	address, err := c.ConnectionString(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", address), nil
+}
@@ -0,0 +1,63 @@
+package milvus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestMilvus(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("milvusdb/milvus:v2.3.5"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connectionString, err := container.ConnectionString(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if connectionString == "" {
+		t.Fatal("expected a connection string")
+	}
+
+	restURL, err := container.RESTURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(restURL + "/v1/vector/collections")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var body struct {
+		Code int `json:"code"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	if body.Code != http.StatusOK {
+		t.Fatalf("expected code %d, got %d", http.StatusOK, body.Code)
+	}
+}
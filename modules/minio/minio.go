@@ -3,8 +3,11 @@ package minio
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -39,6 +42,41 @@ func WithPassword(password string) testcontainers.CustomizeRequestOption {
 	}
 }
 
+// WithBuckets creates the given buckets right after the container is ready, using the MinIO client (mc)
+// shipped with the MinIO image and the credentials of the root user. Existing buckets are ignored.
+func WithBuckets(buckets ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return createBuckets(ctx, c, buckets)
+				},
+			},
+		})
+	}
+}
+
+// createBuckets registers the server in the MinIO client, reading the credentials from the environment
+// of the container, and then creates the buckets.
+func createBuckets(ctx context.Context, c testcontainers.Container, buckets []string) error {
+	cmds := []string{`mc alias set local http://localhost:9000 "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD"`}
+	for _, bucket := range buckets {
+		cmds = append(cmds, "mc mb --ignore-existing local/"+bucket)
+	}
+
+	code, output, err := c.Exec(ctx, []string{"sh", "-c", strings.Join(cmds, " && ")}, exec.Multiplexed())
+	if err != nil {
+		return fmt.Errorf("failed to create buckets: %w", err)
+	}
+
+	if code != 0 {
+		out, _ := io.ReadAll(output)
+		return fmt.Errorf("failed to create buckets, exit code %d: %s", code, string(out))
+	}
+
+	return nil
+}
+
 // ConnectionString returns the connection string for the minio container, using the default 9000 port, and
 // obtaining the host and exposed port from the container.
 func (c *MinioContainer) ConnectionString(ctx context.Context) (string, error) {
@@ -80,3 +80,44 @@ func TestMinio(t *testing.T) {
 		t.Fatalf("expected %d; got %d", contentLength, n)
 	}
 }
+
+func TestMinioWithBuckets(t *testing.T) {
+	ctx := context.Background()
+
+	// withBuckets {
+	container, err := RunContainer(ctx, WithBuckets("bucket-1", "bucket-2"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	url, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	minioClient, err := minio.New(url, &minio.Options{
+		Creds:  credentials.NewStaticV4(container.Username, container.Password, ""),
+		Secure: false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, bucket := range []string{"bucket-1", "bucket-2"} {
+		exists, err := minioClient.BucketExists(ctx, bucket)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !exists {
+			t.Fatalf("expected bucket %s to exist", bucket)
+		}
+	}
+}
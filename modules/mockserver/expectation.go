@@ -0,0 +1,133 @@
+package mockserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPRequest matches the requests received by MockServer.
+// Reference: https://www.mock-server.com/mock_server/creating_expectations.html#request-matchers
+type HTTPRequest struct {
+	// Method is the HTTP method of the request, e.g. "GET".
+	Method string `json:"method,omitempty"`
+	// Path is the path of the request, e.g. "/api/categories". It can be a regular expression.
+	Path string `json:"path,omitempty"`
+	// QueryStringParameters are the query parameters the request must contain.
+	QueryStringParameters map[string][]string `json:"queryStringParameters,omitempty"`
+	// Headers are the headers the request must contain.
+	Headers map[string][]string `json:"headers,omitempty"`
+	// Body is the body the request must match, either a string for an exact match,
+	// or a body matcher, e.g. map[string]any{"type": "JSON", "json": `{"name": "Tools"}`}.
+	Body any `json:"body,omitempty"`
+}
+
+// HTTPResponse is the response returned by MockServer when an expectation is matched.
+type HTTPResponse struct {
+	// StatusCode is the status code of the response. MockServer defaults to 200.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Headers are the headers of the response.
+	Headers map[string][]string `json:"headers,omitempty"`
+	// Body is the body of the response, either a string or a body, e.g. map[string]any{"type": "JSON", "json": ...}.
+	Body any `json:"body,omitempty"`
+}
+
+// Times limits the number of times an expectation can be matched.
+type Times struct {
+	RemainingTimes int  `json:"remainingTimes,omitempty"`
+	Unlimited      bool `json:"unlimited,omitempty"`
+}
+
+// Expectation is a MockServer expectation, which returns the response when a request matches it.
+type Expectation struct {
+	// ID identifies the expectation, so it can be updated. MockServer generates it if empty.
+	ID string `json:"id,omitempty"`
+	// Priority is the priority of the expectation, the higher the earlier it's matched.
+	Priority     int          `json:"priority,omitempty"`
+	HTTPRequest  HTTPRequest  `json:"httpRequest"`
+	HTTPResponse HTTPResponse `json:"httpResponse"`
+	// Times limits the number of times the expectation can be matched. Unlimited if nil.
+	Times *Times `json:"times,omitempty"`
+}
+
+// VerificationTimes is the number of times a request must have been received by MockServer.
+type VerificationTimes struct {
+	AtLeast *int `json:"atLeast,omitempty"`
+	AtMost  *int `json:"atMost,omitempty"`
+}
+
+// Exactly verifies that the request was received exactly n times.
+func Exactly(n int) VerificationTimes {
+	return VerificationTimes{AtLeast: &n, AtMost: &n}
+}
+
+// AtLeast verifies that the request was received at least n times.
+func AtLeast(n int) VerificationTimes {
+	return VerificationTimes{AtLeast: &n}
+}
+
+// AtMost verifies that the request was received at most n times.
+func AtMost(n int) VerificationTimes {
+	return VerificationTimes{AtMost: &n}
+}
+
+type verification struct {
+	HTTPRequest HTTPRequest       `json:"httpRequest"`
+	Times       VerificationTimes `json:"times"`
+}
+
+// CreateExpectations creates the given expectations in MockServer, using the REST API.
+func (c *MockServerContainer) CreateExpectations(ctx context.Context, expectations ...Expectation) error {
+	return c.put(ctx, "/mockserver/expectation", expectations, http.StatusCreated)
+}
+
+// VerifyRequest verifies that MockServer received the requests matching the given request the given number of times.
+// It returns an error with the reason if the verification fails.
+func (c *MockServerContainer) VerifyRequest(ctx context.Context, request HTTPRequest, times VerificationTimes) error {
+	return c.put(ctx, "/mockserver/verify", verification{HTTPRequest: request, Times: times}, http.StatusAccepted)
+}
+
+// Reset clears all the expectations and the recorded requests of MockServer.
+func (c *MockServerContainer) Reset(ctx context.Context) error {
+	return c.put(ctx, "/mockserver/reset", nil, http.StatusOK)
+}
+
+// put sends a PUT request to the MockServer REST API, with the given JSON body, if not nil,
+// and checks the status code of the response.
+func (c *MockServerContainer) put(ctx context.Context, path string, body any, expectedStatus int) error {
+	url, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d for %s: %s", resp.StatusCode, path, string(data))
+	}
+
+	return nil
+}
@@ -45,7 +45,8 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &MockServerContainer{Container: container}, nil
 }
 
-// GetURL returns the URL of the MockServer container
+// URL returns the URL of the MockServer container, e.g. "http://localhost:32768".
+// Both the mocked endpoints and the REST API to manage the expectations are served on it.
 func (c *MockServerContainer) URL(ctx context.Context) (string, error) {
 	host, err := c.Host(ctx)
 	if err != nil {
@@ -57,3 +58,14 @@ func (c *MockServerContainer) URL(ctx context.Context) (string, error) {
 	}
 	return fmt.Sprintf("http://%s:%d", host, port.Int()), nil
 }
+
+// ExpectationURL returns the URL of the REST API endpoint to create expectations,
+// e.g. "http://localhost:32768/mockserver/expectation".
+func (c *MockServerContainer) ExpectationURL(ctx context.Context) (string, error) {
+	url, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return url + "/mockserver/expectation", nil
+}
@@ -0,0 +1,96 @@
+package mockserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestMockServerExpectations(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("mockserver/mockserver:5.15.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	url, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// createExpectations {
+	request := HTTPRequest{
+		Method: http.MethodGet,
+		Path:   "/api/categories",
+	}
+
+	err = container.CreateExpectations(ctx, Expectation{
+		HTTPRequest: request,
+		HTTPResponse: HTTPResponse{
+			StatusCode: http.StatusOK,
+			Headers:    map[string][]string{"Content-Type": {"application/json"}},
+			Body:       `[{"name": "Tools"}]`,
+		},
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(url + "/api/categories")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if !strings.Contains(string(body), "Tools") {
+		t.Fatalf("unexpected body: %s", string(body))
+	}
+
+	// verifyRequest {
+	err = container.VerifyRequest(ctx, request, Exactly(1))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := container.VerifyRequest(ctx, request, AtLeast(2)); err == nil {
+		t.Fatal("expected the verification to fail")
+	}
+
+	// reset {
+	err = container.Reset(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = http.Get(url + "/api/categories")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected status code %d after reset, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
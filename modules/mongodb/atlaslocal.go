@@ -0,0 +1,83 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// defaultAtlasLocalImage is the default MongoDB Atlas Local container image
+const defaultAtlasLocalImage = "mongodb/mongodb-atlas-local:7.0.9"
+
+// AtlasLocalContainer represents the MongoDB Atlas Local container type used in the module,
+// running a single node replica set together with the Atlas Search process (mongot).
+type AtlasLocalContainer struct {
+	testcontainers.Container
+	username string
+	password string
+}
+
+// RunAtlasLocalContainer creates an instance of the MongoDB Atlas Local container type, with Atlas Search enabled,
+// so the $search and $vectorSearch aggregation stages and the search indexes are available. The container is ready
+// once the health check of the image, covering both the database and the search processes, passes.
+// The WithUsername and WithPassword options are supported.
+func RunAtlasLocalContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*AtlasLocalContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        defaultAtlasLocalImage,
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor: wait.ForAll(
+			wait.ForListeningPort("27017/tcp"),
+			wait.ForHealthCheck(),
+		).WithDeadline(2 * time.Minute),
+		Env: map[string]string{},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	// the Atlas Local image reads the credentials from variables prefixed with MONGODB_ instead of MONGO_
+	username := genericContainerReq.Env["MONGO_INITDB_ROOT_USERNAME"]
+	password := genericContainerReq.Env["MONGO_INITDB_ROOT_PASSWORD"]
+	if username != "" && password == "" || username == "" && password != "" {
+		return nil, fmt.Errorf("if you specify username or password, you must provide both of them")
+	}
+
+	if username != "" {
+		genericContainerReq.Env["MONGODB_INITDB_ROOT_USERNAME"] = username
+		genericContainerReq.Env["MONGODB_INITDB_ROOT_PASSWORD"] = password
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AtlasLocalContainer{Container: container, username: username, password: password}, nil
+}
+
+// ConnectionString returns the connection string for the MongoDB Atlas Local container,
+// which sets directConnection, as the replica set advertises the hostname of the container.
+// If you provide a username and a password, the connection string will also include them.
+func (c *AtlasLocalContainer) ConnectionString(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+	port, err := c.MappedPort(ctx, "27017/tcp")
+	if err != nil {
+		return "", err
+	}
+	if c.username != "" && c.password != "" {
+		return fmt.Sprintf("mongodb://%s:%s@%s:%s/?directConnection=true", c.username, c.password, host, port.Port()), nil
+	}
+	return fmt.Sprintf("mongodb://%s:%s/?directConnection=true", host, port.Port()), nil
+}
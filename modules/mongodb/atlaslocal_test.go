@@ -0,0 +1,100 @@
+package mongodb_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+)
+
+func TestAtlasLocal(t *testing.T) {
+	ctx := context.Background()
+
+	// runAtlasLocalContainer {
+	container, err := mongodb.RunAtlasLocalContainer(ctx,
+		mongodb.WithUsername("root"),
+		mongodb.WithPassword("password"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(connStr, "mongodb://root:password@") {
+		t.Fatalf("expected the credentials in the connection string, got %s", connStr)
+	}
+
+	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(connStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = mongoClient.Disconnect(ctx)
+	})
+
+	coll := mongoClient.Database("test").Collection("movies")
+
+	_, err = coll.InsertMany(ctx, []interface{}{
+		bson.M{"title": "The Godfather", "plot": "The aging patriarch of an organized crime dynasty transfers control to his son."},
+		bson.M{"title": "Jaws", "plot": "A giant great white shark terrorizes a beach resort."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// searchIndex {
+	_, err = coll.SearchIndexes().CreateOne(ctx, mongo.SearchIndexModel{
+		Definition: bson.M{"mappings": bson.M{"dynamic": true}},
+		Options:    options.SearchIndexes().SetName("default"),
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the search index is built asynchronously, so the search is retried until the document is found
+	pipeline := mongo.Pipeline{
+		{{Key: "$search", Value: bson.M{"text": bson.M{"query": "shark", "path": "plot"}}}},
+	}
+
+	deadline := time.Now().Add(time.Minute)
+	for {
+		var results []bson.M
+
+		cursor, err := coll.Aggregate(ctx, pipeline)
+		if err == nil {
+			err = cursor.All(ctx, &results)
+		}
+
+		if err == nil && len(results) == 1 {
+			if results[0]["title"] != "Jaws" {
+				t.Fatalf("expected Jaws, got %v", results[0]["title"])
+			}
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 1 result, got %d: %v", len(results), err)
+		}
+
+		time.Sleep(time.Second)
+	}
+}
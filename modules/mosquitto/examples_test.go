@@ -0,0 +1,37 @@
+package mosquitto_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mosquitto"
+)
+
+func ExampleRunContainer() {
+	// runMosquittoContainer {
+	ctx := context.Background()
+
+	mosquittoContainer, err := mosquitto.RunContainer(ctx, testcontainers.WithImage("eclipse-mosquitto:2.0.18"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := mosquittoContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := mosquittoContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
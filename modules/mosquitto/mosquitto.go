@@ -0,0 +1,218 @@
+package mosquitto
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	mqttPort      = "1883/tcp"
+	websocketPort = "9001/tcp"
+	tlsPort       = "8883/tcp"
+
+	configPath   = "/mosquitto/config/mosquitto.conf"
+	passwordPath = "/mosquitto/config/passwd"
+	tlsCertPath  = "/mosquitto/config/certs/server.crt"
+	tlsKeyPath   = "/mosquitto/config/certs/server.key"
+)
+
+// MosquittoContainer represents the Mosquitto container type used in the module
+type MosquittoContainer struct {
+	testcontainers.Container
+	tlsEnabled bool
+}
+
+// RunContainer creates an instance of the Mosquitto container type, with listeners for the
+// MQTT protocol, over TCP and websockets.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*MosquittoContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "eclipse-mosquitto:2.0.18",
+		ExposedPorts: []string{mqttPort, websocketPort},
+		WaitingFor: wait.ForAll(
+			wait.ForLog(`mosquitto version .* running`).AsRegexp(),
+			wait.ForListeningPort(mqttPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	tlsEnabled := settings.TLSCertFile != "" || settings.TLSKeyFile != ""
+	if tlsEnabled {
+		if settings.TLSCertFile == "" || settings.TLSKeyFile == "" {
+			return nil, errors.New("both the TLS certificate and key files are required")
+		}
+
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, tlsPort)
+		genericContainerReq.Files = append(genericContainerReq.Files,
+			testcontainers.ContainerFile{HostFilePath: settings.TLSCertFile, ContainerFilePath: tlsCertPath, FileMode: 0o644},
+			// the key must be readable by the mosquitto user
+			testcontainers.ContainerFile{HostFilePath: settings.TLSKeyFile, ContainerFilePath: tlsKeyPath, FileMode: 0o644},
+		)
+	}
+
+	var passwords []byte
+	if len(settings.Users) > 0 {
+		var err error
+		passwords, err = passwordFile(settings.Users)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var config []byte
+	if settings.ConfigFile != "" {
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      settings.ConfigFile,
+			ContainerFilePath: configPath,
+			FileMode:          0o644,
+		})
+	} else {
+		config = renderConfig(len(settings.Users) > 0, tlsEnabled)
+	}
+
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostCreates: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				if config != nil {
+					if err := c.CopyToContainer(ctx, config, configPath, 0o644); err != nil {
+						return fmt.Errorf("copy config: %w", err)
+					}
+				}
+
+				if passwords != nil {
+					// the password file must be readable by the mosquitto user
+					if err := c.CopyToContainer(ctx, passwords, passwordPath, 0o644); err != nil {
+						return fmt.Errorf("copy password file: %w", err)
+					}
+				}
+
+				return nil
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MosquittoContainer{Container: container, tlsEnabled: tlsEnabled}, nil
+}
+
+// renderConfig returns the content of the mosquitto.conf file, with the MQTT and websockets listeners,
+// and optionally the TLS listener and the password file.
+func renderConfig(withUsers bool, withTLS bool) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("# Injected by testcontainers\n")
+	buf.WriteString("log_dest stdout\n\n")
+
+	buf.WriteString("listener 1883\nprotocol mqtt\n\n")
+	buf.WriteString("listener 9001\nprotocol websockets\n\n")
+
+	if withTLS {
+		buf.WriteString("listener 8883\nprotocol mqtt\n")
+		buf.WriteString("certfile " + tlsCertPath + "\n")
+		buf.WriteString("keyfile " + tlsKeyPath + "\n\n")
+	}
+
+	if withUsers {
+		buf.WriteString("allow_anonymous false\n")
+		buf.WriteString("password_file " + passwordPath + "\n")
+	} else {
+		buf.WriteString("allow_anonymous true\n")
+	}
+
+	return buf.Bytes()
+}
+
+// passwordFile returns the content of a password file for the given users, with the passwords
+// hashed using the salted SHA512 format supported by mosquitto_passwd: "$6$<salt>$<hash>".
+func passwordFile(users map[string]string) ([]byte, error) {
+	usernames := make([]string, 0, len(users))
+	for username := range users {
+		if username == "" || strings.ContainsAny(username, ":\n") {
+			return nil, fmt.Errorf("invalid username: %q", username)
+		}
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+
+	var buf bytes.Buffer
+	for _, username := range usernames {
+		salt := make([]byte, 12)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("generate salt: %w", err)
+		}
+
+		buf.WriteString(username + ":" + hashPassword(users[username], salt) + "\n")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func hashPassword(password string, salt []byte) string {
+	h := sha512.New()
+	h.Write([]byte(password))
+	h.Write(salt)
+
+	return "$6$" + base64.StdEncoding.EncodeToString(salt) + "$" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// BrokerURL returns the URL of the MQTT listener, e.g. "tcp://localhost:32768", to be used by the MQTT clients.
+func (c *MosquittoContainer) BrokerURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "tcp", mqttPort)
+}
+
+// WebSocketURL returns the URL of the websockets listener, e.g. "ws://localhost:32768".
+func (c *MosquittoContainer) WebSocketURL(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, "ws", websocketPort)
+}
+
+// TLSBrokerURL returns the URL of the TLS listener, e.g. "ssl://localhost:32768".
+// It returns an error if TLS is not enabled with the WithTLS option.
+func (c *MosquittoContainer) TLSBrokerURL(ctx context.Context) (string, error) {
+	if !c.tlsEnabled {
+		return "", errors.New("TLS is not enabled")
+	}
+
+	return c.endpoint(ctx, "ssl", tlsPort)
+}
+
+func (c *MosquittoContainer) endpoint(ctx context.Context, scheme string, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, mappedPort.Port())), nil
+}
@@ -0,0 +1,260 @@
+package mosquitto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestMosquitto(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("eclipse-mosquitto:2.0.18"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		// brokerURL {
+		brokerURL, err := container.BrokerURL(ctx)
+		// }
+		require.NoError(t, err)
+
+		assertPublishSubscribe(t, mqtt.NewClientOptions().AddBroker(brokerURL))
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		// webSocketURL {
+		webSocketURL, err := container.WebSocketURL(ctx)
+		// }
+		require.NoError(t, err)
+
+		assertPublishSubscribe(t, mqtt.NewClientOptions().AddBroker(webSocketURL))
+	})
+
+	t.Run("tls-disabled", func(t *testing.T) {
+		_, err := container.TLSBrokerURL(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestMosquittoWithUsers(t *testing.T) {
+	ctx := context.Background()
+
+	// withUser {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("eclipse-mosquitto:2.0.18"),
+		WithUser("sensor", "s3cr3t"),
+		WithUser("dashboard", "p4ssw0rd"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	brokerURL, err := container.BrokerURL(ctx)
+	require.NoError(t, err)
+
+	t.Run("valid-credentials", func(t *testing.T) {
+		assertPublishSubscribe(t, mqtt.NewClientOptions().AddBroker(brokerURL).SetUsername("sensor").SetPassword("s3cr3t"))
+	})
+
+	t.Run("invalid-credentials", func(t *testing.T) {
+		client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(brokerURL).SetUsername("sensor").SetPassword("wrong"))
+		token := client.Connect()
+		require.True(t, token.WaitTimeout(10*time.Second))
+		require.Error(t, token.Error())
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(brokerURL))
+		token := client.Connect()
+		require.True(t, token.WaitTimeout(10*time.Second))
+		require.Error(t, token.Error())
+	})
+}
+
+func TestMosquittoWithTLS(t *testing.T) {
+	ctx := context.Background()
+
+	tmpDir := t.TempDir()
+	certPool := generateCertificate(t, tmpDir)
+
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("eclipse-mosquitto:2.0.18"),
+		WithTLS(filepath.Join(tmpDir, "server.crt"), filepath.Join(tmpDir, "server.key")),
+	)
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// tlsBrokerURL {
+	tlsBrokerURL, err := container.TLSBrokerURL(ctx)
+	require.NoError(t, err)
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(tlsBrokerURL).
+		SetTLSConfig(&tls.Config{RootCAs: certPool})
+	// }
+
+	assertPublishSubscribe(t, opts)
+}
+
+func TestMosquittoWithConfigFile(t *testing.T) {
+	ctx := context.Background()
+
+	// withConfigFile {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("eclipse-mosquitto:2.0.18"),
+		WithConfigFile(filepath.Join("testdata", "mosquitto.conf")),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	brokerURL, err := container.BrokerURL(ctx)
+	require.NoError(t, err)
+
+	assertPublishSubscribe(t, mqtt.NewClientOptions().AddBroker(brokerURL))
+}
+
+func TestRenderConfig(t *testing.T) {
+	t.Run("anonymous", func(t *testing.T) {
+		config := string(renderConfig(false, false))
+
+		assert.Contains(t, config, "listener 1883\nprotocol mqtt\n")
+		assert.Contains(t, config, "listener 9001\nprotocol websockets\n")
+		assert.Contains(t, config, "allow_anonymous true\n")
+		assert.NotContains(t, config, "listener 8883")
+		assert.NotContains(t, config, "password_file")
+	})
+
+	t.Run("users-and-tls", func(t *testing.T) {
+		config := string(renderConfig(true, true))
+
+		assert.Contains(t, config, "listener 8883\nprotocol mqtt\ncertfile "+tlsCertPath+"\nkeyfile "+tlsKeyPath+"\n")
+		assert.Contains(t, config, "allow_anonymous false\n")
+		assert.Contains(t, config, "password_file "+passwordPath+"\n")
+	})
+}
+
+func TestPasswordFile(t *testing.T) {
+	passwords, err := passwordFile(map[string]string{"sensor": "s3cr3t", "dashboard": "p4ssw0rd"})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(passwords)), "\n")
+	require.Len(t, lines, 2)
+
+	username, hash, found := strings.Cut(lines[1], ":")
+	require.True(t, found)
+	assert.Equal(t, "sensor", username)
+
+	parts := strings.Split(hash, "$")
+	require.Len(t, parts, 4)
+	assert.Equal(t, "6", parts[1])
+
+	salt, err := base64.StdEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+
+	expected := sha512.Sum512(append([]byte("s3cr3t"), salt...))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(expected[:]), parts[3])
+
+	_, err = passwordFile(map[string]string{"in:valid": "password"})
+	require.Error(t, err)
+}
+
+func assertPublishSubscribe(t *testing.T, opts *mqtt.ClientOptions) {
+	t.Helper()
+
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	require.True(t, token.WaitTimeout(10*time.Second))
+	require.NoError(t, token.Error())
+	defer client.Disconnect(250)
+
+	received := make(chan string, 1)
+	token = client.Subscribe("testcontainers/topic", 1, func(_ mqtt.Client, msg mqtt.Message) {
+		received <- string(msg.Payload())
+	})
+	require.True(t, token.WaitTimeout(10*time.Second))
+	require.NoError(t, token.Error())
+
+	token = client.Publish("testcontainers/topic", 1, false, "hello")
+	require.True(t, token.WaitTimeout(10*time.Second))
+	require.NoError(t, token.Error())
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "hello", msg)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the message")
+	}
+}
+
+// generateCertificate writes a self-signed certificate for localhost, and its key, to the given directory,
+// returning a pool containing the certificate.
+func generateCertificate(t *testing.T, dir string) *x509.CertPool {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.crt"), certPEM, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o644))
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(certPEM))
+
+	return pool
+}
@@ -0,0 +1,60 @@
+package mosquitto
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// ConfigFile is the path to a mosquitto.conf file in the host, which replaces the generated one.
+	ConfigFile string
+	// Users is a map of usernames (key) and passwords (value) added to the password file.
+	Users map[string]string
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate and key in the host,
+	// used to enable the TLS listener.
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+func defaultOptions() options {
+	return options{
+		Users: make(map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Mosquitto container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithConfigFile sets the path to a mosquitto.conf file in the host, which is used instead of
+// the configuration generated by the module. The file must define the listeners on the 1883 (MQTT)
+// and 9001 (websockets) ports, and it can reference the password file generated by WithUser,
+// at /mosquitto/config/passwd, and the TLS files set with WithTLS, at /mosquitto/config/certs.
+func WithConfigFile(configFile string) Option {
+	return func(o *options) {
+		o.ConfigFile = configFile
+	}
+}
+
+// WithUser adds a user to the password file of the broker, and disables the anonymous access.
+// It can be called multiple times.
+func WithUser(username string, password string) Option {
+	return func(o *options) {
+		o.Users[username] = password
+	}
+}
+
+// WithTLS enables a TLS listener for the MQTT protocol, on the 8883 port, using the PEM encoded
+// certificate and key in the given paths of the host.
+func WithTLS(certFile string, keyFile string) Option {
+	return func(o *options) {
+		o.TLSCertFile = certFile
+		o.TLSKeyFile = keyFile
+	}
+}
@@ -2,10 +2,16 @@ package mssql
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/url"
 	"strings"
+	"unicode"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -23,12 +29,17 @@ type MSSQLServerContainer struct {
 	username string
 }
 
+// WithAcceptEULA accepts the End-User License Agreement of the MS SQL Server image,
+// which is required for the container to start.
 func WithAcceptEULA() testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["ACCEPT_EULA"] = "Y"
 	}
 }
 
+// WithPassword sets the password of the system administrator (sa) user. It must satisfy the
+// complexity rules of the MS SQL Server password policy, which are checked before the container starts.
+// An empty password sets the default one.
 func WithPassword(password string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		if password == "" {
@@ -58,6 +69,10 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		opt.Customize(&genericContainerReq)
 	}
 
+	if err := validatePassword(genericContainerReq.Env["MSSQL_SA_PASSWORD"]); err != nil {
+		return nil, err
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
 	if err != nil {
 		return nil, err
@@ -69,6 +84,87 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &MSSQLServerContainer{Container: container, password: password, username: username}, nil
 }
 
+// WithInitSQL runs the given SQL scripts, in order, right after the server is ready,
+// using the sqlcmd tool shipped with the image and the credentials of the sa user.
+// The scripts can use the GO batch separator, and the container fails to start if any of them fails.
+func WithInitSQL(files ...io.Reader) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					for i, f := range files {
+						if err := runInitSQL(ctx, c, i, f); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			},
+		})
+	}
+}
+
+// runInitSQL copies the script into the container and runs it with sqlcmd, which is located
+// at mssql-tools18 in the most recent images, requiring to trust the self-signed certificate.
+func runInitSQL(ctx context.Context, c testcontainers.Container, index int, script io.Reader) error {
+	content, err := io.ReadAll(script)
+	if err != nil {
+		return fmt.Errorf("failed to read init script %d: %w", index, err)
+	}
+
+	scriptPath := fmt.Sprintf("/tmp/testcontainers-init-%d.sql", index)
+	if err := c.CopyToContainer(ctx, content, scriptPath, 0o644); err != nil {
+		return fmt.Errorf("failed to copy init script %d: %w", index, err)
+	}
+
+	sqlcmd := `if [ -x /opt/mssql-tools18/bin/sqlcmd ]; then sqlcmd="/opt/mssql-tools18/bin/sqlcmd -C"; else sqlcmd=/opt/mssql-tools/bin/sqlcmd; fi; ` +
+		`$sqlcmd -S localhost -U ` + defaultUsername + ` -P "$MSSQL_SA_PASSWORD" -b -i ` + scriptPath
+
+	code, output, err := c.Exec(ctx, []string{"sh", "-c", sqlcmd}, exec.Multiplexed())
+	if err != nil {
+		return fmt.Errorf("failed to run init script %d: %w", index, err)
+	}
+
+	if code != 0 {
+		out, _ := io.ReadAll(output)
+		return fmt.Errorf("init script %d exited with code %d: %s", index, code, string(out))
+	}
+
+	return nil
+}
+
+// validatePassword checks the password against the complexity rules of the MS SQL Server password policy:
+// it must be at least 8 characters long, and contain characters from three of the following four sets:
+// uppercase letters, lowercase letters, digits and symbols.
+// See https://learn.microsoft.com/en-us/sql/relational-databases/security/password-policy
+func validatePassword(password string) error {
+	if len(password) < 8 {
+		return errors.New("the password must be at least 8 characters long")
+	}
+
+	var upper, lower, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+
+	if upper+lower+digit+symbol < 3 {
+		return errors.New("the password must contain characters from three of the following four sets: uppercase letters, lowercase letters, digits and symbols")
+	}
+
+	return nil
+}
+
+// ConnectionString returns the connection string for the sqlserver driver, using the credentials
+// of the sa user. The extra arguments are added as query parameters, e.g. "encrypt=false".
 func (c *MSSQLServerContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
 	host, err := c.Host(ctx)
 	if err != nil {
@@ -80,9 +176,12 @@ func (c *MSSQLServerContainer) ConnectionString(ctx context.Context, args ...str
 		return "", err
 	}
 
-	extraArgs := strings.Join(args, "&")
-
-	connStr := fmt.Sprintf("sqlserver://%s:%s@%s:%s?%s", c.username, c.password, host, containerPort.Port(), extraArgs)
+	u := url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(c.username, c.password),
+		Host:     net.JoinHostPort(host, containerPort.Port()),
+		RawQuery: strings.Join(args, "&"),
+	}
 
-	return connStr, nil
+	return u.String(), nil
 }
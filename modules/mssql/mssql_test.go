@@ -3,6 +3,7 @@ package mssql
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 
 	_ "github.com/microsoft/go-mssqldb"
@@ -146,29 +147,95 @@ func TestMSSQLServerWithCustomStrongPassword(t *testing.T) {
 	}
 }
 
-// tests that a weak password is not accepted by the container due to Microsoft's password strength policy
+// tests that a weak password is rejected before the container is started, due to Microsoft's password strength policy
 func TestMSSQLServerWithInvalidPassword(t *testing.T) {
 	ctx := context.Background()
 
 	container, err := RunContainer(ctx,
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("Password validation failed")),
 		WithAcceptEULA(),
 		WithPassword("weakPassword"),
 	)
-
 	if err == nil {
-		t.Log("Success: Received invalid password validation docker log.")
-	} else {
-		t.Fatalf("Expected a password validation log but got error: %s", err)
+		t.Cleanup(func() {
+			if err := container.Terminate(ctx); err != nil {
+				t.Fatalf("failed to terminate container: %s", err)
+			}
+		})
+		t.Fatal("expected an error for a weak password")
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		password string
+		valid    bool
+	}{
+		{password: "Strong@Passw0rd", valid: true},
+		{password: "Passw0rd", valid: true},
+		{password: "passw0rd!", valid: true},
+		{password: "P@ss0", valid: false},
+		{password: "weakPassword", valid: false},
+		{password: "12345678", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			err := validatePassword(tt.password)
+			if tt.valid && err != nil {
+				t.Fatalf("expected %q to be valid, got %s", tt.password, err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatalf("expected %q to be invalid", tt.password)
+			}
+		})
+	}
+}
+
+func TestMSSQLServerWithInitSQL(t *testing.T) {
+	ctx := context.Background()
+
+	// withInitSQL {
+	container, err := RunContainer(ctx,
+		WithAcceptEULA(),
+		WithInitSQL(strings.NewReader(`
+CREATE DATABASE testcontainers;
+GO
+USE testcontainers;
+CREATE TABLE greetings (message NVARCHAR(128) NOT NULL);
+INSERT INTO greetings VALUES ('hello');
+GO
+`)),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	// Clean up the container after the test is complete
 	t.Cleanup(func() {
 		if err := container.Terminate(ctx); err != nil {
 			t.Fatalf("failed to terminate container: %s", err)
 		}
 	})
+
+	connectionString, err := container.ConnectionString(ctx, "database=testcontainers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlserver", connectionString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var message string
+	if err := db.QueryRowContext(ctx, "SELECT message FROM greetings").Scan(&message); err != nil {
+		t.Fatal(err)
+	}
+
+	if message != "hello" {
+		t.Fatalf("expected message to be 'hello', got %q", message)
+	}
 }
 
 func TestMSSQLServerWithAlternativeImage(t *testing.T) {
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"net"
 	"path/filepath"
 	"strings"
 
@@ -30,6 +31,8 @@ type MySQLContainer struct {
 	database string
 }
 
+// WithDefaultCredentials applies the default credentials to the container request.
+// It will look up for MYSQL environment variables.
 func WithDefaultCredentials() testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		username := req.Env["MYSQL_USER"]
@@ -90,6 +93,10 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	return &MySQLContainer{container, username, password, database}, nil
 }
 
+// ConnectionString returns the DSN to connect to the MySQL container, in the format expected by
+// the github.com/go-sql-driver/mysql driver, using the credentials and database of the container
+// and the host and mapped port of 3306/tcp. The extra arguments are appended as query parameters,
+// e.g. "tls=skip-verify" or "parseTime=true".
 func (c *MySQLContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
 	containerPort, err := c.MappedPort(ctx, "3306/tcp")
 	if err != nil {
@@ -109,28 +116,35 @@ func (c *MySQLContainer) ConnectionString(ctx context.Context, args ...string) (
 		extraArgs = "?" + extraArgs
 	}
 
-	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s%s", c.username, c.password, host, containerPort.Port(), c.database, extraArgs)
+	connectionString := fmt.Sprintf("%s:%s@tcp(%s)/%s%s", c.username, c.password, net.JoinHostPort(host, containerPort.Port()), c.database, extraArgs)
 	return connectionString, nil
 }
 
+// WithUsername sets the initial username to be created when the container starts.
+// If the username is "root", no extra user is created, and the password is set for the root user.
 func WithUsername(username string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MYSQL_USER"] = username
 	}
 }
 
+// WithPassword sets the password of the user created when the container starts.
+// An empty password can only be used with the root user.
 func WithPassword(password string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MYSQL_PASSWORD"] = password
 	}
 }
 
+// WithDatabase sets the name of the database to be created when the container starts.
 func WithDatabase(database string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Env["MYSQL_DATABASE"] = database
 	}
 }
 
+// WithConfigFile copies the given my.cnf file into the /etc/mysql/conf.d directory of the container,
+// so its settings are applied when the server starts.
 func WithConfigFile(configFile string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		cf := testcontainers.ContainerFile{
@@ -142,6 +156,8 @@ func WithConfigFile(configFile string) testcontainers.CustomizeRequestOption {
 	}
 }
 
+// WithScripts copies the given *.sql, *.sql.gz or *.sh scripts into the /docker-entrypoint-initdb.d
+// directory of the container, which are executed in alphabetical order when the database is initialised.
 func WithScripts(scripts ...string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		var initScripts []testcontainers.ContainerFile
@@ -3,12 +3,15 @@ package mysql_test
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/docker/go-connections/nat"
 	// Import mysql into the scope of this package (required)
 	_ "github.com/go-sql-driver/mysql"
 
+	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/mysql"
 )
 
@@ -149,3 +152,44 @@ func TestMySQLWithScripts(t *testing.T) {
 		t.Fatal("The expected record was not found in the database.")
 	}
 }
+
+// hostContainer is a container that only resolves its host and the mapped port
+type hostContainer struct {
+	testcontainers.Container
+	host string
+}
+
+func (c hostContainer) Host(_ context.Context) (string, error) {
+	return c.host, nil
+}
+
+func (c hostContainer) MappedPort(_ context.Context, _ nat.Port) (nat.Port, error) {
+	return "49153/tcp", nil
+}
+
+func TestMySQLConnectionStringHostPort(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{host: "localhost", expected: "%s:%s@tcp(localhost:49153)/%s?tls=skip-verify"},
+		{host: "127.0.0.1", expected: "%s:%s@tcp(127.0.0.1:49153)/%s?tls=skip-verify"},
+		{host: "::1", expected: "%s:%s@tcp([::1]:49153)/%s?tls=skip-verify"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			container := &mysql.MySQLContainer{Container: hostContainer{host: test.host}}
+
+			connectionString, err := container.ConnectionString(context.Background(), "tls=skip-verify")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := fmt.Sprintf(test.expected, "", "", "")
+			if connectionString != expected {
+				t.Fatalf("expected connection string %q, got %q", expected, connectionString)
+			}
+		})
+	}
+}
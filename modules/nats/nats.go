@@ -47,7 +47,11 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	// Include the command line arguments
 	for k, v := range settings.CmdArgs {
 		// always prepend the dash because it was removed in the options
-		genericContainerReq.Cmd = append(genericContainerReq.Cmd, []string{"--" + k, v}...)
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--"+k)
+		// boolean flags, like "js", do not receive a value
+		if v != "" {
+			genericContainerReq.Cmd = append(genericContainerReq.Cmd, v)
+		}
 	}
 
 	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
@@ -79,3 +83,19 @@ func (c *NATSContainer) ConnectionString(ctx context.Context, args ...string) (s
 	uri := fmt.Sprintf("nats://%s:%s", hostIP, mappedPort.Port())
 	return uri, nil
 }
+
+// MonitoringURL returns the URL of the HTTP monitoring endpoint of the NATS container,
+// e.g. to check the state of JetStream using the "/jsz" path.
+func (c *NATSContainer) MonitoringURL(ctx context.Context) (string, error) {
+	mappedPort, err := c.MappedPort(ctx, defaultMonitoringPort)
+	if err != nil {
+		return "", err
+	}
+
+	hostIP, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", hostIP, mappedPort.Port()), nil
+}
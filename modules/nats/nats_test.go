@@ -2,6 +2,7 @@ package nats
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/nats-io/nats.go"
@@ -72,3 +73,55 @@ func TestNATS(t *testing.T) {
 		t.Fatalf("expected message to be 'hello', got '%s'", msg.Data)
 	}
 }
+
+func TestNATSWithArguments(t *testing.T) {
+	ctx := context.Background()
+
+	// withArguments {
+	container, err := RunContainer(ctx,
+		WithArgument("js", ""),
+		WithArgument("--max_payload", "2MB"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// monitoringURL {
+	monitoringURL, err := container.MonitoringURL(ctx)
+	// }
+	if err != nil {
+		t.Fatalf("failed to get monitoring URL: %s", err)
+	}
+
+	resp, err := http.Get(monitoringURL + "/jsz")
+	if err != nil {
+		t.Fatalf("failed to get JetStream state: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	uri, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("failed to get connection string: %s", err)
+	}
+
+	nc, err := nats.Connect(uri)
+	if err != nil {
+		t.Fatalf("failed to connect to nats: %s", err)
+	}
+	defer nc.Close()
+
+	if nc.MaxPayload() != 2*1024*1024 {
+		t.Fatalf("expected max payload to be 2MB, got %d", nc.MaxPayload())
+	}
+}
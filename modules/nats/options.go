@@ -27,12 +27,14 @@ func (o CmdOption) Customize(req *testcontainers.GenericContainerRequest) {
 	// NOOP to satisfy interface.
 }
 
+// WithUsername sets the username required by the NATS server to accept client connections.
 func WithUsername(username string) CmdOption {
 	return func(o *options) {
 		o.CmdArgs["user"] = username
 	}
 }
 
+// WithPassword sets the password required by the NATS server to accept client connections.
 func WithPassword(password string) CmdOption {
 	return func(o *options) {
 		o.CmdArgs["pass"] = password
@@ -41,8 +43,9 @@ func WithPassword(password string) CmdOption {
 
 // WithArgument adds an argument and its value to the NATS container.
 // The argument flag does not need to include the dashes.
+// An empty value adds the flag alone, which is needed for boolean flags, e.g. WithArgument("js", "").
 func WithArgument(flag string, value string) CmdOption {
-	flag = strings.ReplaceAll(flag, "--", "") // remove all dashes to make it easier to use
+	flag = strings.TrimLeft(flag, "-") // remove all dashes to make it easier to use
 
 	return func(o *options) {
 		o.CmdArgs[flag] = value
@@ -51,6 +51,7 @@ func (c Neo4jContainer) BoltUrl(ctx context.Context) (string, error) {
 // RunContainer creates an instance of the Neo4j container type
 func RunContainer(ctx context.Context, options ...testcontainers.ContainerCustomizer) (*Neo4jContainer, error) {
 	httpPort, _ := nat.NewPort("tcp", defaultHttpPort)
+	boltPort, _ := nat.NewPort("tcp", defaultBoltPort)
 	request := testcontainers.ContainerRequest{
 		Image: fmt.Sprintf("docker.io/%s:%s", defaultImageName, defaultTag),
 		Env: map[string]string{
@@ -61,9 +62,11 @@ func RunContainer(ctx context.Context, options ...testcontainers.ContainerCustom
 			fmt.Sprintf("%s/tcp", defaultHttpPort),
 			fmt.Sprintf("%s/tcp", defaultHttpsPort),
 		},
+		// the container is ready once both the Bolt and the HTTP connectors accept connections
 		WaitingFor: &wait.MultiStrategy{
 			Strategies: []wait.Strategy{
 				wait.NewLogStrategy("Bolt enabled on"),
+				wait.ForListeningPort(boltPort),
 				&wait.HTTPStrategy{
 					Port:              httpPort,
 					StatusCodeMatcher: isHttpOk(),
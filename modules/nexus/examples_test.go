@@ -0,0 +1,37 @@
+package nexus_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/nexus"
+)
+
+func ExampleRunContainer() {
+	// runNexusContainer {
+	ctx := context.Background()
+
+	nexusContainer, err := nexus.RunContainer(ctx, testcontainers.WithImage("sonatype/nexus3:3.64.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := nexusContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := nexusContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,209 @@
+package nexus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "8081/tcp"
+
+	adminPasswordPath = "/nexus-data/admin.password"
+)
+
+// DefaultAdminUser is the user of the admin account.
+const DefaultAdminUser = "admin"
+
+// NexusContainer represents the Nexus container type used in the module
+type NexusContainer struct {
+	testcontainers.Container
+	adminPassword string
+}
+
+// RunContainer creates an instance of the Nexus Repository 3 container type. Nexus generates the password of
+// the admin account when it starts for the first time, which is read from the filesystem of the container.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*NexusContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "sonatype/nexus3:3.64.0",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"INSTALL4J_ADD_VM_PARAMS": "-Xms512m -Xmx512m -XX:MaxDirectMemorySize=512m -Djava.util.prefs.userRoot=/nexus-data/javaprefs",
+		},
+		WaitingFor: wait.ForHTTP("/service/rest/v1/status/writable").WithPort(httpPort).WithStartupTimeout(5 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	var adminPassword string
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				var err error
+				adminPassword, err = readAdminPassword(ctx, c)
+				return err
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NexusContainer{Container: container, adminPassword: adminPassword}, nil
+}
+
+// readAdminPassword reads the generated password of the admin account, which Nexus writes to the
+// data directory until the password is changed.
+func readAdminPassword(ctx context.Context, c testcontainers.Container) (string, error) {
+	reader, err := c.CopyFileFromContainer(ctx, adminPasswordPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the admin password: %w", err)
+	}
+	defer reader.Close()
+
+	password, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the admin password: %w", err)
+	}
+
+	return strings.TrimSpace(string(password)), nil
+}
+
+// AdminPassword returns the password of the admin account, generated by Nexus.
+func (c *NexusContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// URL returns the base URL of the Nexus UI and API, e.g. "http://localhost:32768".
+func (c *NexusContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// RepositoryURL returns the URL of the given repository, e.g. "http://localhost:32768/repository/maven-central/",
+// to be used by the Maven and Go clients, authenticated with the admin account.
+func (c *NexusContainer) RepositoryURL(ctx context.Context, name string) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/repository/" + name + "/", nil
+}
+
+// CreateMavenProxyRepository creates a Maven repository proxying the release artifacts of the given remote
+// repository, e.g. "https://repo1.maven.org/maven2/".
+func (c *NexusContainer) CreateMavenProxyRepository(ctx context.Context, name string, remoteURL string) error {
+	return c.createRepository(ctx, "maven/proxy", map[string]interface{}{
+		"name":          name,
+		"online":        true,
+		"storage":       storage(""),
+		"proxy":         proxy(remoteURL),
+		"negativeCache": map[string]interface{}{"enabled": true, "timeToLive": 1440},
+		"httpClient":    map[string]interface{}{"blocked": false, "autoBlock": true},
+		"maven":         map[string]interface{}{"versionPolicy": "RELEASE", "layoutPolicy": "STRICT"},
+	})
+}
+
+// CreateMavenHostedRepository creates a Maven repository hosting the release artifacts deployed to it.
+func (c *NexusContainer) CreateMavenHostedRepository(ctx context.Context, name string) error {
+	return c.createRepository(ctx, "maven/hosted", map[string]interface{}{
+		"name":    name,
+		"online":  true,
+		"storage": storage("ALLOW"),
+		"maven":   map[string]interface{}{"versionPolicy": "RELEASE", "layoutPolicy": "STRICT"},
+	})
+}
+
+// CreateGoProxyRepository creates a Go repository proxying the modules of the given remote Go module proxy,
+// e.g. "https://proxy.golang.org", to be used with the GOPROXY environment variable.
+func (c *NexusContainer) CreateGoProxyRepository(ctx context.Context, name string, remoteURL string) error {
+	return c.createRepository(ctx, "go/proxy", map[string]interface{}{
+		"name":          name,
+		"online":        true,
+		"storage":       storage(""),
+		"proxy":         proxy(remoteURL),
+		"negativeCache": map[string]interface{}{"enabled": true, "timeToLive": 1440},
+		"httpClient":    map[string]interface{}{"blocked": false, "autoBlock": true},
+	})
+}
+
+func storage(writePolicy string) map[string]interface{} {
+	s := map[string]interface{}{
+		"blobStoreName":               "default",
+		"strictContentTypeValidation": true,
+	}
+	if writePolicy != "" {
+		s["writePolicy"] = writePolicy
+	}
+
+	return s
+}
+
+func proxy(remoteURL string) map[string]interface{} {
+	return map[string]interface{}{
+		"remoteUrl":      remoteURL,
+		"contentMaxAge":  1440,
+		"metadataMaxAge": 1440,
+	}
+}
+
+// createRepository creates a repository of the given format and type, e.g. "maven/proxy", with the repositories API.
+func (c *NexusContainer) createRepository(ctx context.Context, formatAndType string, payload map[string]interface{}) error {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := "/service/rest/v1/repositories/" + formatAndType
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(DefaultAdminUser, c.adminPassword)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, path, respBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package nexus_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/nexus"
+)
+
+func TestNexus(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := nexus.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// adminPassword {
+	password := container.AdminPassword()
+	// }
+	if password == "" {
+		t.Fatal("expected the generated admin password")
+	}
+
+	t.Run("maven-proxy", func(t *testing.T) {
+		// createMavenProxyRepository {
+		err := container.CreateMavenProxyRepository(ctx, "maven-central", "https://repo1.maven.org/maven2/")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// repositoryURL {
+		repositoryURL, err := container.RepositoryURL(ctx, "maven-central")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body := request(t, http.MethodGet, repositoryURL+"junit/junit/4.13.2/junit-4.13.2.pom", password, nil, http.StatusOK)
+		if !strings.Contains(body, "<artifactId>junit</artifactId>") {
+			t.Fatalf("expected the POM of JUnit, got %s", body)
+		}
+	})
+
+	t.Run("maven-hosted", func(t *testing.T) {
+		// createMavenHostedRepository {
+		err := container.CreateMavenHostedRepository(ctx, "releases")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		repositoryURL, err := container.RepositoryURL(ctx, "releases")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pom := `<project><modelVersion>4.0.0</modelVersion><groupId>org.testcontainers</groupId><artifactId>demo</artifactId><version>1.0.0</version></project>`
+		artifactURL := repositoryURL + "org/testcontainers/demo/1.0.0/demo-1.0.0.pom"
+
+		request(t, http.MethodPut, artifactURL, password, strings.NewReader(pom), http.StatusCreated)
+		if body := request(t, http.MethodGet, artifactURL, password, nil, http.StatusOK); body != pom {
+			t.Fatalf("expected the deployed POM, got %s", body)
+		}
+	})
+
+	t.Run("go-proxy", func(t *testing.T) {
+		// createGoProxyRepository {
+		err := container.CreateGoProxyRepository(ctx, "go-proxy", "https://proxy.golang.org")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		repositoryURL, err := container.RepositoryURL(ctx, "go-proxy")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body := request(t, http.MethodGet, repositoryURL+"github.com/stretchr/testify/@v/list", password, nil, http.StatusOK)
+		if !strings.Contains(body, "v1.8.4") {
+			t.Fatalf("expected the versions of testify, got %s", body)
+		}
+	})
+
+	if err := container.CreateMavenHostedRepository(ctx, "releases"); err == nil {
+		t.Fatal("expected an error when creating an existing repository")
+	}
+}
+
+func request(t *testing.T, method string, url string, password string, body io.Reader, expectedStatus int) string {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(nexus.DefaultAdminUser, password)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	respBody := &bytes.Buffer{}
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		t.Fatalf("expected status code %d for %s %s, got %d: %s", expectedStatus, method, url, resp.StatusCode, respBody)
+	}
+
+	return respBody.String()
+}
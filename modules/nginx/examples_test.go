@@ -0,0 +1,37 @@
+package nginx_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/nginx"
+)
+
+func ExampleRunContainer() {
+	// runNginxContainer {
+	ctx := context.Background()
+
+	nginxContainer, err := nginx.RunContainer(ctx, testcontainers.WithImage("nginx:1.25.3-alpine"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := nginxContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := nginxContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,151 @@
+package nginx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort  = "80/tcp"
+	httpsPort = "443/tcp"
+
+	configPath = "/etc/nginx/conf.d/default.conf"
+)
+
+const (
+	// CertPath is the path of the TLS certificate in the container, to be used by the ssl_certificate
+	// directive of the configuration.
+	CertPath = "/etc/nginx/certs/server.crt"
+	// KeyPath is the path of the TLS key in the container, to be used by the ssl_certificate_key
+	// directive of the configuration.
+	KeyPath = "/etc/nginx/certs/server.key"
+)
+
+// defaultTLSConfig serves the default page of the image on both the HTTP and the HTTPS ports.
+const defaultTLSConfig = `server {
+    listen 80;
+    listen 443 ssl;
+    server_name localhost;
+
+    ssl_certificate     ` + CertPath + `;
+    ssl_certificate_key ` + KeyPath + `;
+
+    location / {
+        root  /usr/share/nginx/html;
+        index index.html;
+    }
+}
+`
+
+// NginxContainer represents the Nginx container type used in the module
+type NginxContainer struct {
+	testcontainers.Container
+	tlsEnabled bool
+}
+
+// RunContainer creates an instance of the Nginx container type, serving the default page of the image,
+// or the configuration set with the WithConfig option, e.g. a reverse proxy in front of other containers.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*NginxContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "nginx:1.25.3-alpine",
+		ExposedPorts: []string{httpPort},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	tlsEnabled := settings.CertFile != "" || settings.KeyFile != ""
+	if tlsEnabled && (settings.CertFile == "" || settings.KeyFile == "") {
+		return nil, errors.New("both the certificate and the key files are required to enable TLS")
+	}
+
+	var config []byte
+	if settings.Config != nil {
+		var err error
+		config, err = io.ReadAll(settings.Config)
+		if err != nil {
+			return nil, fmt.Errorf("read config: %w", err)
+		}
+	} else if tlsEnabled {
+		config = []byte(defaultTLSConfig)
+	}
+
+	if config != nil {
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, config, configPath, 0o644)
+				},
+			},
+		})
+	}
+
+	// the configuration is expected to listen on the HTTP port, and on the HTTPS port when TLS is enabled
+	waitStrategies := []wait.Strategy{wait.ForListeningPort(httpPort)}
+	if tlsEnabled {
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, httpsPort)
+		genericContainerReq.Files = append(genericContainerReq.Files,
+			testcontainers.ContainerFile{HostFilePath: settings.CertFile, ContainerFilePath: CertPath, FileMode: 0o644},
+			testcontainers.ContainerFile{HostFilePath: settings.KeyFile, ContainerFilePath: KeyPath, FileMode: 0o600},
+		)
+		waitStrategies = append(waitStrategies, wait.ForListeningPort(httpsPort))
+	}
+
+	if genericContainerReq.WaitingFor == nil {
+		genericContainerReq.WaitingFor = wait.ForAll(waitStrategies...).WithDeadline(time.Minute)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NginxContainer{Container: container, tlsEnabled: tlsEnabled}, nil
+}
+
+// URL returns the base HTTP URL of Nginx, e.g. "http://localhost:32768".
+func (c *NginxContainer) URL(ctx context.Context) (string, error) {
+	return c.url(ctx, "http", httpPort)
+}
+
+// HTTPSURL returns the base HTTPS URL of Nginx, e.g. "https://localhost:32768".
+// It returns an error if TLS is not enabled with the WithTLS option.
+func (c *NginxContainer) HTTPSURL(ctx context.Context) (string, error) {
+	if !c.tlsEnabled {
+		return "", errors.New("TLS is not enabled, use the WithTLS option")
+	}
+
+	return c.url(ctx, "https", httpsPort)
+}
+
+func (c *NginxContainer) url(ctx context.Context, scheme string, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s://%s:%s", scheme, host, mappedPort.Port()), nil
+}
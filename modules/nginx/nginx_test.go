@@ -0,0 +1,238 @@
+package nginx_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/nginx"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func TestNginx(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := nginx.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	url, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := get(t, http.DefaultClient, url)
+	if !strings.Contains(body, "Welcome to nginx!") {
+		t.Fatalf("expected the default page, got %s", body)
+	}
+
+	if _, err := container.HTTPSURL(ctx); err == nil {
+		t.Fatal("expected an error when TLS is not enabled")
+	}
+}
+
+func TestNginxWithConfig(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	// the backend is another Nginx container, reachable through its network alias
+	backend, err := nginx.RunContainer(ctx,
+		network.WithNetwork([]string{"backend"}, nw),
+		nginx.WithConfig(strings.NewReader(`server {
+    listen 80;
+    location / {
+        return 200 "hello from the backend";
+    }
+}`)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := backend.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// withConfig {
+	tmpl := template.Must(template.New("proxy").Parse(`server {
+    listen 80;
+    location /api/ {
+        proxy_pass http://{{ .Upstream }}/;
+    }
+}`))
+
+	config := &bytes.Buffer{}
+	if err := tmpl.Execute(config, map[string]string{"Upstream": "backend:80"}); err != nil {
+		t.Fatal(err)
+	}
+
+	proxy, err := nginx.RunContainer(ctx, network.WithNetwork([]string{"proxy"}, nw), nginx.WithConfig(config))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := proxy.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	url, err := proxy.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body := get(t, http.DefaultClient, url+"/api/"); body != "hello from the backend" {
+		t.Fatalf("expected the response of the backend, got %s", body)
+	}
+}
+
+func TestNginxWithTLS(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	pool := generateCertificate(t, dir)
+
+	// withTLS {
+	container, err := nginx.RunContainer(ctx, nginx.WithTLS(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// httpsURL {
+	httpsURL, err := container.HTTPSURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, ServerName: "localhost"},
+		},
+	}
+
+	body := get(t, client, httpsURL)
+	if !strings.Contains(body, "Welcome to nginx!") {
+		t.Fatalf("expected the default page, got %s", body)
+	}
+}
+
+func TestNginxWithTLSWithoutKey(t *testing.T) {
+	_, err := nginx.RunContainer(context.Background(), nginx.WithTLS("server.crt", ""))
+	if err == nil {
+		t.Fatal("expected an error without the key file")
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(body)
+}
+
+// generateCertificate writes a self-signed certificate for localhost, and its key, to the given directory,
+// returning a pool containing the certificate.
+func generateCertificate(t *testing.T, dir string) *x509.CertPool {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certTemplate := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &certTemplate, &certTemplate, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	if err := os.WriteFile(filepath.Join(dir, "server.crt"), certPEM, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "server.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		t.Fatal("failed to add the certificate to the pool")
+	}
+
+	return pool
+}
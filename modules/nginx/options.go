@@ -0,0 +1,49 @@
+package nginx
+
+import (
+	"io"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Config is the reader of the server configuration.
+	Config io.Reader
+	// CertFile is the path of the TLS certificate on the host.
+	CertFile string
+	// KeyFile is the path of the TLS key on the host.
+	KeyFile string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Nginx container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithConfig sets the server configuration, which replaces the /etc/nginx/conf.d/default.conf file of the image,
+// e.g. a configuration rendered with text/template, proxying the requests to other containers of the same network.
+// The configuration must listen on the port 80, and on the port 443 when TLS is enabled.
+func WithConfig(config io.Reader) Option {
+	return func(o *options) {
+		o.Config = config
+	}
+}
+
+// WithTLS copies the given TLS certificate and key files of the host to the CertPath and KeyPath of the container,
+// and exposes the HTTPS port. Without the WithConfig option, the default page is also served with HTTPS.
+func WithTLS(certFile string, keyFile string) Option {
+	return func(o *options) {
+		o.CertFile = certFile
+		o.KeyFile = keyFile
+	}
+}
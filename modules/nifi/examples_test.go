@@ -0,0 +1,37 @@
+package nifi_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/nifi"
+)
+
+func ExampleRunContainer() {
+	// runNiFiContainer {
+	ctx := context.Background()
+
+	nifiContainer, err := nifi.RunContainer(ctx, testcontainers.WithImage("apache/nifi:1.25.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := nifiContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := nifiContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
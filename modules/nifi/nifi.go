@@ -0,0 +1,189 @@
+package nifi
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpsPort = "8443/tcp"
+
+	// proxyHostPath is the file read by the entrypoint before starting NiFi, containing the address of the host,
+	// which must be allowed as a proxy host, as NiFi rejects the requests with an unknown host header.
+	proxyHostPath = "/tmp/testcontainers-proxy-host"
+)
+
+const (
+	// DefaultUsername is the default user name of the single user.
+	DefaultUsername = "admin"
+	// DefaultPassword is the default password of the single user.
+	DefaultPassword = "testcontainers"
+)
+
+// NiFiContainer represents the NiFi container type used in the module
+type NiFiContainer struct {
+	testcontainers.Container
+	username string
+	password string
+}
+
+// RunContainer creates an instance of the NiFi container type, secured with HTTPS and the single user
+// authentication, using the self-signed certificate generated by NiFi. As NiFi only accepts the requests
+// sent to the allowed hosts, the address of the mapped port is allowed once the container is started,
+// and the container is ready once the REST API is available, which usually takes more than a minute.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*NiFiContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apache/nifi:1.25.0",
+		ExposedPorts: []string{httpsPort},
+		Env: map[string]string{
+			"NIFI_WEB_HTTPS_PORT": "8443",
+		},
+		// wait for the proxy host, which is copied after the container is started
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd: []string{
+			fmt.Sprintf("while [ ! -f %[1]s ]; do sleep 0.1; done; export NIFI_WEB_PROXY_HOST=$(cat %[1]s); exec ../scripts/start.sh", proxyHostPath),
+		},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if len(settings.Password) < 12 {
+		return nil, errors.New("the password must be at least 12 characters long")
+	}
+
+	genericContainerReq.Env["SINGLE_USER_CREDENTIALS_USERNAME"] = settings.Username
+	genericContainerReq.Env["SINGLE_USER_CREDENTIALS_PASSWORD"] = settings.Password
+
+	// the wait strategy can only be checked once the proxy host is copied
+	waitStrategy := genericContainerReq.WaitingFor
+	if waitStrategy == nil {
+		waitStrategy = wait.ForAll(
+			wait.ForLog("NiFi has started"),
+			wait.ForHTTP("/nifi-api/access/config").
+				WithPort(httpsPort).
+				WithTLS(true).
+				WithAllowInsecure(true),
+		).WithDeadline(5 * time.Minute)
+	}
+	genericContainerReq.WaitingFor = nil
+
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				host, err := c.Host(ctx)
+				if err != nil {
+					return err
+				}
+
+				port, err := c.MappedPort(ctx, httpsPort)
+				if err != nil {
+					return err
+				}
+
+				if err := c.CopyToContainer(ctx, []byte(net.JoinHostPort(host, port.Port())), proxyHostPath, 0o644); err != nil {
+					return fmt.Errorf("failed to copy the proxy host into the container: %w", err)
+				}
+
+				return waitStrategy.WaitUntilReady(ctx, c)
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NiFiContainer{Container: container, username: settings.Username, password: settings.Password}, nil
+}
+
+// URL returns the base URL of NiFi, e.g. "https://localhost:32768", where the UI is served under /nifi
+// and the REST API under /nifi-api. The certificate is self-signed, so the clients must skip its verification.
+func (c *NiFiContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpsPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s:%s", host, port.Port()), nil
+}
+
+// Username returns the user name of the single user.
+func (c *NiFiContainer) Username() string {
+	return c.username
+}
+
+// Password returns the password of the single user.
+func (c *NiFiContainer) Password() string {
+	return c.password
+}
+
+// AccessToken authenticates the single user, returning a token to be sent as a bearer token
+// in the Authorization header of the requests to the REST API.
+func (c *NiFiContainer) AccessToken(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("username", c.username)
+	form.Set("password", c.password)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/nifi-api/access/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			// the certificate is self-signed
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("unexpected status code %d for POST /nifi-api/access/token: %s", resp.StatusCode, body)
+	}
+
+	return string(body), nil
+}
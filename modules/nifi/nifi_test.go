@@ -0,0 +1,84 @@
+package nifi_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/nifi"
+)
+
+func TestNiFi(t *testing.T) {
+	ctx := context.Background()
+
+	// withSingleUserCredentials {
+	container, err := nifi.RunContainer(ctx, nifi.WithSingleUserCredentials("nifi", "s3cr3t-passw0rd"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	baseURL, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// accessToken {
+	token, err := container.AccessToken(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/nifi-api/flow/current-user", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			// the certificate is self-signed
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the current user", resp.StatusCode)
+	}
+
+	var user struct {
+		Identity string `json:"identity"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		t.Fatal(err)
+	}
+
+	if user.Identity != "nifi" {
+		t.Fatalf("expected the current user to be nifi, got %s", user.Identity)
+	}
+}
+
+func TestNiFiWithShortPassword(t *testing.T) {
+	_, err := nifi.RunContainer(context.Background(), nifi.WithSingleUserCredentials("nifi", "short"))
+	if err == nil {
+		t.Fatal("expected an error for a password shorter than 12 characters")
+	}
+}
@@ -0,0 +1,39 @@
+package nifi
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Username is the user name of the single user.
+	Username string
+	// Password is the password of the single user.
+	Password string
+}
+
+func defaultOptions() options {
+	return options{
+		Username: DefaultUsername,
+		Password: DefaultPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the NiFi container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithSingleUserCredentials sets the user name and the password of the single user, which authenticates
+// to the UI and the REST API. NiFi requires a password of at least 12 characters.
+func WithSingleUserCredentials(username string, password string) Option {
+	return func(o *options) {
+		o.Username = username
+		o.Password = password
+	}
+}
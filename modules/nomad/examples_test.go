@@ -0,0 +1,37 @@
+package nomad_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/nomad"
+)
+
+func ExampleRunContainer() {
+	// runNomadContainer {
+	ctx := context.Background()
+
+	nomadContainer, err := nomad.RunContainer(ctx, testcontainers.WithImage("hashicorp/nomad:1.7.3"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := nomadContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := nomadContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,169 @@
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "4646/tcp"
+
+// NomadContainer represents the Nomad container type used in the module
+type NomadContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Nomad container type, running an agent in dev mode, which is
+// both a server and a client with in-memory state. The container is privileged, as the client needs to
+// manage cgroups, and it's ready once the client node has registered, so jobs can be scheduled.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*NomadContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "hashicorp/nomad:1.7.3",
+		ExposedPorts: []string{httpPort},
+		Cmd:          []string{"agent", "-dev", "-bind=0.0.0.0"},
+		Privileged:   true,
+		WaitingFor: wait.ForHTTP("/v1/nodes").
+			WithPort(httpPort).
+			WithResponseMatcher(nodeReady).
+			WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NomadContainer{Container: container}, nil
+}
+
+// nodeReady reports whether the node list contains a ready node.
+func nodeReady(body io.Reader) bool {
+	var nodes []struct {
+		Status string `json:"Status"`
+	}
+	if err := json.NewDecoder(body).Decode(&nodes); err != nil {
+		return false
+	}
+
+	for _, node := range nodes {
+		if node.Status == "ready" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HTTPAddress returns the address of the HTTP API, e.g. "http://localhost:32768", to be used as the NOMAD_ADDR
+// environment variable of the Nomad CLI, or as the address of the github.com/hashicorp/nomad/api client.
+func (c *NomadContainer) HTTPAddress(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// ParseJob converts a job specification in HCL format to its JSON representation, as expected by the
+// job endpoints of the HTTP API. It returns an error if the specification cannot be parsed.
+func (c *NomadContainer) ParseJob(ctx context.Context, jobHCL string) (json.RawMessage, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"JobHCL":       jobHCL,
+		"Canonicalize": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, http.MethodPost, "/v1/jobs/parse", body)
+}
+
+// ValidateJob parses and validates a job specification in HCL format, without registering it.
+// It returns an error describing the validation errors of the job, if any.
+func (c *NomadContainer) ValidateJob(ctx context.Context, jobHCL string) error {
+	job, err := c.ParseJob(ctx, jobHCL)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"Job": job})
+	if err != nil {
+		return err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPut, "/v1/validate/job", body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		ValidationErrors []string `json:"ValidationErrors"`
+		Error            string   `json:"Error"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return err
+	}
+
+	errs := make([]error, 0, len(result.ValidationErrors))
+	for _, msg := range result.ValidationErrors {
+		errs = append(errs, errors.New(msg))
+	}
+	if len(errs) == 0 && result.Error != "" {
+		errs = append(errs, errors.New(result.Error))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *NomadContainer) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	address, err := c.HTTPAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, address+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,112 @@
+package nomad_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/nomad"
+)
+
+const jobSpec = `
+job "web" {
+  datacenters = ["dc1"]
+
+  group "app" {
+    count = %COUNT%
+
+    task "server" {
+      driver = "docker"
+
+      config {
+        image = "nginx:1.25.3-alpine"
+      }
+    }
+  }
+}
+`
+
+func TestNomad(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := nomad.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// httpAddress {
+	address, err := container.HTTPAddress(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(address + "/v1/status/leader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var leader string
+	if err := json.NewDecoder(resp.Body).Decode(&leader); err != nil {
+		t.Fatal(err)
+	}
+	if leader == "" {
+		t.Fatal("expected the dev agent to be the leader")
+	}
+
+	t.Run("parse-job", func(t *testing.T) {
+		// parseJob {
+		job, err := container.ParseJob(ctx, strings.ReplaceAll(jobSpec, "%COUNT%", "2"))
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var parsed struct {
+			ID         string `json:"ID"`
+			TaskGroups []struct {
+				Count int `json:"Count"`
+			} `json:"TaskGroups"`
+		}
+		if err := json.Unmarshal(job, &parsed); err != nil {
+			t.Fatal(err)
+		}
+
+		if parsed.ID != "web" || len(parsed.TaskGroups) != 1 || parsed.TaskGroups[0].Count != 2 {
+			t.Fatalf("unexpected parsed job %s", job)
+		}
+	})
+
+	t.Run("validate-job", func(t *testing.T) {
+		// validateJob {
+		err := container.ValidateJob(ctx, strings.ReplaceAll(jobSpec, "%COUNT%", "2"))
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("validate-invalid-job", func(t *testing.T) {
+		err := container.ValidateJob(ctx, strings.ReplaceAll(jobSpec, "%COUNT%", "-1"))
+		if err == nil {
+			t.Fatal("expected a validation error for a negative count")
+		}
+	})
+
+	t.Run("parse-invalid-job", func(t *testing.T) {
+		_, err := container.ParseJob(ctx, `job "web" {`)
+		if err == nil {
+			t.Fatal("expected a parse error for an unterminated block")
+		}
+	})
+}
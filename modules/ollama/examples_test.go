@@ -0,0 +1,37 @@
+package ollama_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/ollama"
+)
+
+func ExampleRunContainer() {
+	// runOllamaContainer {
+	ctx := context.Background()
+
+	ollamaContainer, err := ollama.RunContainer(ctx, testcontainers.WithImage("ollama/ollama:0.1.25"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := ollamaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := ollamaContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
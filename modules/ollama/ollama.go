@@ -0,0 +1,121 @@
+package ollama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const defaultPort = "11434/tcp"
+
+// OllamaContainer represents the Ollama container type used in the module
+type OllamaContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Ollama container type, running the Ollama server.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*OllamaContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "ollama/ollama:0.1.25",
+		ExposedPorts: []string{defaultPort},
+		WaitingFor: wait.ForHTTP("/").WithPort(defaultPort).WithResponseMatcher(func(body io.Reader) bool {
+			data, err := io.ReadAll(body)
+			return err == nil && strings.Contains(string(data), "Ollama is running")
+		}),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OllamaContainer{Container: container}, nil
+}
+
+// ConnectionString returns the URL of the Ollama server, e.g. "http://localhost:32768",
+// to be used as the base URL of the Ollama API clients.
+func (c *OllamaContainer) ConnectionString(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, defaultPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// PullModel pulls the given model, e.g. "llama2" or "all-minilm", from the Ollama library,
+// so it can be used by the clients. Pulling a model can take a long time, so the context
+// should have a long enough deadline.
+func (c *OllamaContainer) PullModel(ctx context.Context, model string) error {
+	code, output, err := c.Exec(ctx, []string{"ollama", "pull", model}, exec.Multiplexed())
+	if err != nil {
+		return fmt.Errorf("failed to pull model %q: %w", model, err)
+	}
+
+	if code != 0 {
+		data, _ := io.ReadAll(output)
+		return fmt.Errorf("failed to pull model %q: exit code %d: %s", model, code, string(data))
+	}
+
+	return nil
+}
+
+// Commit commits the current state of the container, including the pulled models, into
+// the given image, e.g. "ollama-llama2:latest", so the next test runs can start from it,
+// with testcontainers.WithImage, without pulling the models again.
+// If the image already exists, it's not committed again.
+func (c *OllamaContainer) Commit(ctx context.Context, targetImage string) error {
+	if targetImage == "" {
+		return errors.New("the target image cannot be empty")
+	}
+
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	list, err := cli.ImageList(ctx, types.ImageListOptions{
+		Filters: filters.NewArgs(filters.Arg("reference", targetImage)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list images: %w", err)
+	}
+
+	if len(list) > 0 {
+		return nil
+	}
+
+	_, err = cli.ContainerCommit(ctx, c.GetContainerID(), container.CommitOptions{
+		Reference: targetImage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit container: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestOllama(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("ollama/ollama:0.1.25"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// connectionString {
+	connectionString, err := container.ConnectionString(ctx)
+	// }
+	require.NoError(t, err)
+
+	resp, err := http.Get(connectionString)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestOllamaPullModelAndCommit(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("ollama/ollama:0.1.25"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	// pullModel {
+	err = container.PullModel(ctx, "all-minilm")
+	// }
+	require.NoError(t, err)
+
+	assertModels(t, ctx, container, "all-minilm:latest")
+
+	// commitOllamaContainer {
+	targetImage := fmt.Sprintf("ollama-all-minilm:%s", uuid.NewString())
+
+	err = container.Commit(ctx, targetImage)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+		require.NoError(t, err)
+		defer cli.Close()
+
+		_, err = cli.ImageRemove(ctx, targetImage, types.ImageRemoveOptions{Force: true, PruneChildren: true})
+		require.NoError(t, err)
+	})
+
+	// committing an existing image is a no-op
+	require.NoError(t, container.Commit(ctx, targetImage))
+
+	// runCommittedImage {
+	committed, err := RunContainer(ctx, testcontainers.WithImage(targetImage))
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, committed.Terminate(ctx))
+	})
+
+	// the model is already available in the committed image
+	assertModels(t, ctx, committed, "all-minilm:latest")
+}
+
+func assertModels(t *testing.T, ctx context.Context, container *OllamaContainer, expected ...string) {
+	t.Helper()
+
+	connectionString, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	resp, err := http.Get(connectionString + "/api/tags")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var tags struct {
+		Models []struct {
+			Name string `json:"name"`
+		} `json:"models"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tags))
+
+	names := make([]string, 0, len(tags.Models))
+	for _, m := range tags.Models {
+		names = append(names, m.Name)
+	}
+
+	assert.Subset(t, names, expected)
+}
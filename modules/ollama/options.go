@@ -0,0 +1,26 @@
+package ollama
+
+import (
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// WithGPU requests all the GPUs of the Docker host for the container, so the models run on them.
+// It requires a Docker host with GPU support, e.g. with the NVIDIA Container Toolkit installed.
+func WithGPU() testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		modifier := req.HostConfigModifier
+
+		req.HostConfigModifier = func(hc *container.HostConfig) {
+			if modifier != nil {
+				modifier(hc)
+			}
+
+			hc.DeviceRequests = append(hc.DeviceRequests, container.DeviceRequest{
+				Count:        -1,
+				Capabilities: [][]string{{"gpu"}},
+			})
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package opa_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/opa"
+)
+
+func ExampleRunContainer() {
+	// runOPAContainer {
+	ctx := context.Background()
+
+	opaContainer, err := opa.RunContainer(ctx, testcontainers.WithImage("openpolicyagent/opa:0.60.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := opaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := opaContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,176 @@
+package opa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "8181/tcp"
+
+	policiesPath = "/policies"
+	bundlesPath  = "/bundles"
+)
+
+// OPAContainer represents the OPA container type used in the module
+type OPAContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the OPA container type, running OPA as a server which loads the
+// policies, data files and bundles set by the options, and which accepts policies and data at runtime
+// through its REST API.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*OPAContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "openpolicyagent/opa:0.60.0",
+		ExposedPorts: []string{httpPort},
+		Cmd:          []string{"run", "--server", "--addr=0.0.0.0:8181"},
+		WaitingFor:   wait.ForHTTP("/health").WithPort(httpPort),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	// the files are loaded in the order they are passed, so policies can depend on the bundles
+	for i, path := range settings.BundleFiles {
+		if !strings.HasSuffix(path, ".tar.gz") {
+			return nil, fmt.Errorf("the bundle %s must be a .tar.gz file", path)
+		}
+
+		containerPath := fmt.Sprintf("%s/%d-%s", bundlesPath, i, filepath.Base(path))
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      path,
+			ContainerFilePath: containerPath,
+			FileMode:          0o644,
+		})
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, containerPath)
+	}
+
+	for i, path := range settings.PolicyFiles {
+		// the index avoids collisions between files with the same name in different host directories
+		containerPath := fmt.Sprintf("%s/%d-%s", policiesPath, i, filepath.Base(path))
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      path,
+			ContainerFilePath: containerPath,
+			FileMode:          0o644,
+		})
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, containerPath)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OPAContainer{Container: container}, nil
+}
+
+// URL returns the URL of the REST API, e.g. "http://localhost:32768".
+func (c *OPAContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, mappedPort.Port())), nil
+}
+
+// UploadPolicy creates or updates the policy with the given ID, from its Rego source, returning an error
+// if the policy doesn't compile.
+func (c *OPAContainer) UploadPolicy(ctx context.Context, id string, rego string) error {
+	_, err := c.do(ctx, http.MethodPut, "/v1/policies/"+url.PathEscape(id), "text/plain", []byte(rego), http.StatusOK)
+	return err
+}
+
+// UploadData creates or replaces the document at the given slash separated path of the data document,
+// e.g. "acme/roles" for data.acme.roles, with the JSON encoding of the given value.
+func (c *OPAContainer) UploadData(ctx context.Context, path string, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPut, "/v1/data/"+strings.Trim(path, "/"), "application/json", body, http.StatusNoContent)
+	return err
+}
+
+// Evaluate evaluates the document at the given slash separated path of the data document, e.g. "authz/allow"
+// for data.authz.allow, with the JSON encoding of the given value as the input, returning the JSON encoded
+// result. The result is nil when the document is undefined, e.g. a rule without a default value whose
+// conditions are not met by the input.
+func (c *OPAContainer) Evaluate(ctx context.Context, path string, input interface{}) (json.RawMessage, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": input})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/v1/data/"+strings.Trim(path, "/"), "application/json", body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Result, nil
+}
+
+func (c *OPAContainer) do(ctx context.Context, method string, path string, contentType string, body []byte, expectedStatus int) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
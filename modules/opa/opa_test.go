@@ -0,0 +1,169 @@
+package opa_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/opa"
+)
+
+func TestOPA(t *testing.T) {
+	ctx := context.Background()
+
+	// withPolicyFiles {
+	container, err := opa.RunContainer(ctx, opa.WithPolicyFiles("testdata/authz.rego", "testdata/data.json"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	t.Run("evaluate", func(t *testing.T) {
+		tests := []struct {
+			user     string
+			action   string
+			expected string
+		}{
+			{user: "alice", action: "write", expected: "true"},
+			{user: "bob", action: "read", expected: "true"},
+			{user: "bob", action: "write", expected: "false"},
+			{user: "eve", action: "read", expected: "false"},
+		}
+
+		for _, test := range tests {
+			// evaluate {
+			result, err := container.Evaluate(ctx, "authz/allow", map[string]string{"user": test.user, "action": test.action})
+			// }
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(result) != test.expected {
+				t.Fatalf("expected %s for %s to %s, got %s", test.expected, test.user, test.action, result)
+			}
+		}
+	})
+
+	t.Run("upload", func(t *testing.T) {
+		// uploadPolicy {
+		err := container.UploadPolicy(ctx, "admin", `package admin
+
+import rego.v1
+
+allow if input.user in data.admins
+`)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the rule is undefined as long as there are no admins
+		result, err := container.Evaluate(ctx, "admin/allow", map[string]string{"user": "alice"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != nil {
+			t.Fatalf("expected an undefined result, got %s", result)
+		}
+
+		// uploadData {
+		err = container.UploadData(ctx, "admins", []string{"alice"})
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err = container.Evaluate(ctx, "admin/allow", map[string]string{"user": "alice"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(result) != "true" {
+			t.Fatalf("expected true, got %s", result)
+		}
+	})
+
+	t.Run("invalid-policy", func(t *testing.T) {
+		if err := container.UploadPolicy(ctx, "invalid", "package invalid\n\nallow if {"); err == nil {
+			t.Fatal("expected an error for a policy which doesn't compile")
+		}
+	})
+}
+
+func TestOPAWithBundle(t *testing.T) {
+	ctx := context.Background()
+
+	bundle := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	writeBundle(t, bundle, map[string]string{
+		"/.manifest":            `{"roots": ["greeting"]}`,
+		"/greeting/policy.rego": "package greeting\n\nimport rego.v1\n\nmessage := sprintf(\"hello %s\", [input.name])\n",
+	})
+
+	// withBundle {
+	container, err := opa.RunContainer(ctx, opa.WithBundle(bundle))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	result, err := container.Evaluate(ctx, "greeting/message", map[string]string{"name": "world"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(result) != `"hello world"` {
+		t.Fatalf("expected the greeting, got %s", result)
+	}
+}
+
+func TestOPAWithInvalidBundle(t *testing.T) {
+	_, err := opa.RunContainer(context.Background(), opa.WithBundle("testdata/authz.rego"))
+	if err == nil {
+		t.Fatal("expected an error for a bundle which is not a .tar.gz file")
+	}
+}
+
+// writeBundle writes a bundle with the given files, by path, to the given .tar.gz file.
+func writeBundle(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
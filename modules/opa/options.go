@@ -0,0 +1,44 @@
+package opa
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// PolicyFiles are the host paths of the Rego policies, and of the JSON or YAML data files, to load.
+	PolicyFiles []string
+	// BundleFiles are the host paths of the bundles to load, as .tar.gz files.
+	BundleFiles []string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the OPA container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithPolicyFiles loads the given Rego policies, and JSON or YAML data files, at startup. The data of the files
+// is loaded at the root of the data document, e.g. a data.json file with {"roles": {...}} is available as
+// data.roles. It can be called multiple times.
+func WithPolicyFiles(paths ...string) Option {
+	return func(o *options) {
+		o.PolicyFiles = append(o.PolicyFiles, paths...)
+	}
+}
+
+// WithBundle loads the given bundle at startup, as built by the "opa build" command, e.g. bundle.tar.gz.
+// It can be called multiple times, as long as the roots of the bundles don't overlap.
+func WithBundle(path string) Option {
+	return func(o *options) {
+		o.BundleFiles = append(o.BundleFiles, path)
+	}
+}
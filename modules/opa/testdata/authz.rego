@@ -0,0 +1,10 @@
+package authz
+
+import rego.v1
+
+default allow := false
+
+allow if {
+	some role in data.roles[input.user]
+	role == input.action
+}
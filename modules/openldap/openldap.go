@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"path/filepath"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -15,7 +17,6 @@ const (
 	defaultUser     = "admin"
 	defaultPassword = "adminpassword"
 	defaultRoot     = "dc=example,dc=org"
-	defaultAdminDn  = "cn=admin,dc=example,dc=org"
 )
 
 // OpenLDAPContainer represents the OpenLDAP container type used in the module
@@ -42,13 +43,29 @@ func (c *OpenLDAPContainer) ConnectionString(ctx context.Context, args ...string
 	return connStr, nil
 }
 
+// AdminDN returns the distinguished name of the admin user, e.g. "cn=admin,dc=example,dc=org",
+// to be used to bind the LDAP clients.
+func (c *OpenLDAPContainer) AdminDN() string {
+	return adminDN(c.adminUsername, c.rootDn)
+}
+
+// AdminPassword returns the password of the admin user.
+func (c *OpenLDAPContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
 // LoadLdif loads an ldif file into the OpenLDAP container
 func (c *OpenLDAPContainer) LoadLdif(ctx context.Context, ldif []byte) error {
 	err := c.CopyToContainer(ctx, ldif, "/tmp/ldif.ldif", 0o644)
 	if err != nil {
 		return err
 	}
-	code, output, err := c.Exec(ctx, []string{"ldapadd", "-H", "ldap://localhost:1389", "-x", "-D", fmt.Sprintf("cn=%s,%s", c.adminUsername, c.rootDn), "-w", c.adminPassword, "-f", "/tmp/ldif.ldif"})
+	return ldapAdd(ctx, c, c.AdminDN(), c.adminPassword, "/tmp/ldif.ldif")
+}
+
+// ldapAdd adds the entries of the given ldif file in the container, binding with the given credentials.
+func ldapAdd(ctx context.Context, container testcontainers.Container, bindDN string, password string, ldifPath string) error {
+	code, output, err := container.Exec(ctx, []string{"ldapadd", "-H", "ldap://localhost:1389", "-x", "-D", bindDN, "-w", password, "-f", ldifPath}, exec.Multiplexed())
 	if err != nil {
 		return err
 	}
@@ -59,6 +76,10 @@ func (c *OpenLDAPContainer) LoadLdif(ctx context.Context, ldif []byte) error {
 	return nil
 }
 
+func adminDN(username string, root string) string {
+	return fmt.Sprintf("cn=%s,%s", username, root)
+}
+
 // WithAdminUsername sets the initial admin username to be created when the container starts
 // It is used in conjunction with WithAdminPassword to set a username and its password.
 // It will create the specified user with admin power.
@@ -84,12 +105,18 @@ func WithRoot(root string) testcontainers.CustomizeRequestOption {
 	}
 }
 
-// WithInitialLdif sets the initial ldif file to be loaded into the OpenLDAP container
+// WithInitialLdif sets the initial ldif file to be loaded into the OpenLDAP container.
+// The entries are added with the admin user right after the container is ready, so the
+// admin credentials and the root can be set in any order. It can be called multiple times,
+// and the files are loaded in the same order.
 func WithInitialLdif(ldif string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
+		// each file needs its own path, so that multiple files can be loaded
+		containerPath := fmt.Sprintf("/initial_ldif/%d_%s", len(req.Files), filepath.Base(ldif))
+
 		req.Files = append(req.Files, testcontainers.ContainerFile{
 			HostFilePath:      ldif,
-			ContainerFilePath: "/initial_ldif.ldif",
+			ContainerFilePath: containerPath,
 			FileMode:          0o644,
 		})
 
@@ -99,15 +126,7 @@ func WithInitialLdif(ldif string) testcontainers.CustomizeRequestOption {
 					username := req.Env["LDAP_ADMIN_USERNAME"]
 					rootDn := req.Env["LDAP_ROOT"]
 					password := req.Env["LDAP_ADMIN_PASSWORD"]
-					code, output, err := container.Exec(ctx, []string{"ldapadd", "-H", "ldap://localhost:1389", "-x", "-D", fmt.Sprintf("cn=%s,%s", username, rootDn), "-w", password, "-f", "/initial_ldif.ldif"})
-					if err != nil {
-						return err
-					}
-					if code != 0 {
-						data, _ := io.ReadAll(output)
-						return errors.New(string(data))
-					}
-					return nil
+					return ldapAdd(ctx, container, adminDN(username, rootDn), password, containerPath)
 				},
 			},
 		})
@@ -3,6 +3,7 @@ package openldap
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-ldap/ldap/v3"
@@ -234,3 +235,64 @@ userPassword: Password1
 		t.Fatal("Invalid entry returned", result.Entries[0].DN)
 	}
 }
+
+func TestOpenLDAPWithMultipleInitialLdif(t *testing.T) {
+	ctx := context.Background()
+
+	// multipleInitialLdif {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("bitnami/openldap:2.6.6"),
+		WithRoot("dc=mydomain,dc=com"),
+		WithAdminUsername("root"),
+		WithAdminPassword("rootpassword"),
+		WithInitialLdif(filepath.Join("testdata", "users.ldif")),
+		WithInitialLdif(filepath.Join("testdata", "more-users.ldif")),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if container.AdminDN() != "cn=root,dc=mydomain,dc=com" {
+		t.Fatal("Invalid admin DN", container.AdminDN())
+	}
+
+	connectionString, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := ldap.DialURL(connectionString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// adminBind {
+	err = client.Bind(container.AdminDN(), container.AdminPassword())
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Search(&ldap.SearchRequest{
+		BaseDN:     "ou=users,dc=mydomain,dc=com",
+		Scope:      ldap.ScopeWholeSubtree,
+		Filter:     "(|(uid=first.user)(uid=second.user))",
+		Attributes: []string{"dn"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Entries) != 2 {
+		t.Fatal("Invalid number of entries returned", result.Entries)
+	}
+}
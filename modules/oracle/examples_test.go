@@ -0,0 +1,37 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/oracle"
+)
+
+func ExampleRunContainer() {
+	// runOracleContainer {
+	ctx := context.Background()
+
+	oracleContainer, err := oracle.RunContainer(ctx, testcontainers.WithImage("gvenzl/oracle-free:23.3-slim-faststart"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := oracleContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := oracleContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,58 @@
+package oracle
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Username is the name of the application user created when the container starts.
+	Username string
+	// Password is the password of the application user, also used for the SYS and SYSTEM users.
+	Password string
+	// Database is the name of the pluggable database created when the container starts.
+	// It's empty when the default pluggable database is used.
+	Database string
+}
+
+func defaultOptions() options {
+	return options{
+		Username: defaultUser,
+		Password: defaultPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Oracle container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUsername sets the name of the application user to be created when the container starts.
+// If it is not specified, then the "test" user will be used.
+func WithUsername(username string) Option {
+	return func(o *options) {
+		o.Username = username
+	}
+}
+
+// WithPassword sets the password of the application user, which is also used for the SYS and SYSTEM users.
+// If it is not specified, then the "test" password will be used.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.Password = password
+	}
+}
+
+// WithDatabase sets the name of a pluggable database to be created when the container starts,
+// owned by the application user. If it is not specified, then the default pluggable database
+// of the image, FREEPDB1, will be used.
+func WithDatabase(database string) Option {
+	return func(o *options) {
+		o.Database = database
+	}
+}
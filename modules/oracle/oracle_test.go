@@ -0,0 +1,104 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	_ "github.com/sijms/go-ora/v2"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestOracle(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("gvenzl/oracle-free:23.3-slim-faststart"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("oracle", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var user string
+	if err := db.QueryRowContext(ctx, "SELECT USER FROM DUAL").Scan(&user); err != nil {
+		t.Fatal(err)
+	}
+
+	if user != "TEST" {
+		t.Fatalf("expected user to be TEST, got %s", user)
+	}
+}
+
+func TestOracleWithDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	// withCredentials {
+	container, err := RunContainer(ctx,
+		WithUsername("app"),
+		WithPassword("s3cr3t"),
+		WithDatabase("APPDB"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// ezConnect {
+	ezConnect, err := container.EZConnect(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(ezConnect, "/APPDB") {
+		t.Fatalf("expected the EZConnect string to use the APPDB service, got %s", ezConnect)
+	}
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("oracle", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, "CREATE TABLE greetings (message VARCHAR2(128))"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOracleWithSystemUser(t *testing.T) {
+	_, err := RunContainer(context.Background(), WithUsername("system"))
+	if err == nil {
+		t.Fatal("expected an error using the system user as application user")
+	}
+}
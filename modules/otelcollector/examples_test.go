@@ -0,0 +1,37 @@
+package otelcollector_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/otelcollector"
+)
+
+func ExampleRunContainer() {
+	// runOtelCollectorContainer {
+	ctx := context.Background()
+
+	otelcollectorContainer, err := otelcollector.RunContainer(ctx, testcontainers.WithImage("otel/opentelemetry-collector:0.93.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := otelcollectorContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := otelcollectorContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
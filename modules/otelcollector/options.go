@@ -0,0 +1,43 @@
+package otelcollector
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Config is the content of the configuration file of the collector.
+	Config string
+	// ConfigFile is the path of the configuration file of the collector on the host.
+	ConfigFile string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the OpenTelemetry Collector container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithConfig sets the content of the configuration file of the collector, replacing the default
+// configuration. It cannot be combined with WithConfigFile.
+func WithConfig(config string) Option {
+	return func(o *options) {
+		o.Config = config
+	}
+}
+
+// WithConfigFile sets the path of the configuration file of the collector on the host, replacing the default
+// configuration. It cannot be combined with WithConfig.
+func WithConfigFile(configFile string) Option {
+	return func(o *options) {
+		o.ConfigFile = configFile
+	}
+}
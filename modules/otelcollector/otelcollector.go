@@ -0,0 +1,153 @@
+package otelcollector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	otlpGRPCPort = "4317/tcp"
+	otlpHTTPPort = "4318/tcp"
+
+	configPath = "/etc/otelcol/config.yaml"
+)
+
+// defaultConfig receives the signals with the OTLP receivers, and prints them to the logs of the container
+// with the debug exporter.
+const defaultConfig = `receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: 0.0.0.0:4317
+      http:
+        endpoint: 0.0.0.0:4318
+
+exporters:
+  debug:
+    verbosity: detailed
+
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [debug]
+    metrics:
+      receivers: [otlp]
+      exporters: [debug]
+    logs:
+      receivers: [otlp]
+      exporters: [debug]
+`
+
+// OtelCollectorContainer represents the OpenTelemetry Collector container type used in the module
+type OtelCollectorContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the OpenTelemetry Collector container type. By default, the collector
+// receives the traces, metrics and logs with the OTLP receivers, and prints them with the debug exporter,
+// whose output is read back with the DebugOutput method.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*OtelCollectorContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "otel/opentelemetry-collector:0.93.0",
+		ExposedPorts: []string{otlpGRPCPort, otlpHTTPPort},
+		Cmd:          []string{"--config=" + configPath},
+		WaitingFor:   wait.ForLog("Everything is ready. Begin running and processing data.").WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.Config != "" && settings.ConfigFile != "" {
+		return nil, errors.New("the WithConfig and WithConfigFile options cannot be combined")
+	}
+
+	if settings.ConfigFile != "" {
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      settings.ConfigFile,
+			ContainerFilePath: configPath,
+			FileMode:          0o644,
+		})
+	} else {
+		config := settings.Config
+		if config == "" {
+			config = defaultConfig
+		}
+
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, []byte(config), configPath, 0o644)
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OtelCollectorContainer{Container: container}, nil
+}
+
+// OTLPGRPCEndpoint returns the "host:port" endpoint of the OTLP gRPC receiver, to be used by the
+// OpenTelemetry exporters, e.g. with otlptracegrpc.WithEndpoint and otlptracegrpc.WithInsecure.
+func (c *OtelCollectorContainer) OTLPGRPCEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpGRPCPort)
+}
+
+// OTLPHTTPEndpoint returns the "host:port" endpoint of the OTLP HTTP receiver, to be used by the
+// OpenTelemetry exporters, e.g. with otlptracehttp.WithEndpoint and otlptracehttp.WithInsecure.
+func (c *OtelCollectorContainer) OTLPHTTPEndpoint(ctx context.Context) (string, error) {
+	return c.endpoint(ctx, otlpHTTPPort)
+}
+
+// DebugOutput returns the logs of the collector, which include the signals printed by the debug exporter.
+// The signals are exported asynchronously, so the tests usually retry until the expected output is returned.
+func (c *OtelCollectorContainer) DebugOutput(ctx context.Context) (string, error) {
+	logs, err := c.Logs(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer logs.Close()
+
+	output, err := io.ReadAll(logs)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func (c *OtelCollectorContainer) endpoint(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
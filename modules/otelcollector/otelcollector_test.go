@@ -0,0 +1,189 @@
+package otelcollector_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/otelcollector"
+)
+
+// otlpLog is a log record in the OTLP JSON encoding, sent as is to the OTLP HTTP receiver
+// to avoid depending on the OpenTelemetry SDK.
+const otlpLog = `{
+  "resourceLogs": [{
+    "scopeLogs": [{
+      "logRecords": [{"timeUnixNano": "%d", "severityText": "INFO", "body": {"stringValue": "hello from the test"}}]
+    }]
+  }]
+}`
+
+// otlpSpan is a span in the OTLP JSON encoding.
+const otlpSpan = `{
+  "resourceSpans": [{
+    "scopeSpans": [{
+      "spans": [{
+        "traceId": "5b8efff798038103d269b633813fc60c",
+        "spanId": "eee19b7ec3c1b174",
+        "name": "place-order",
+        "startTimeUnixNano": "%d",
+        "endTimeUnixNano": "%d"
+      }]
+    }]
+  }]
+}`
+
+func TestOtelCollector(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := otelcollector.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// otlpHTTPEndpoint {
+	endpoint, err := container.OTLPHTTPEndpoint(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	post(t, "http://"+endpoint+"/v1/logs", fmt.Sprintf(otlpLog, time.Now().UnixNano()))
+
+	waitForDebugOutput(t, container, "hello from the test")
+}
+
+func TestOtelCollectorWithConfigFile(t *testing.T) {
+	ctx := context.Background()
+
+	// withConfigFile {
+	container, err := otelcollector.RunContainer(ctx, otelcollector.WithConfigFile(filepath.Join("testdata", "config.yaml")))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	endpoint, err := container.OTLPHTTPEndpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	post(t, "http://"+endpoint+"/v1/traces", fmt.Sprintf(otlpSpan, start.UnixNano(), start.Add(time.Millisecond).UnixNano()))
+
+	// the attributes processor of the configuration file adds the environment attribute
+	waitForDebugOutput(t, container, "-> environment: Str(test)")
+}
+
+func TestOtelCollectorWithConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// withConfig {
+	container, err := otelcollector.RunContainer(ctx, otelcollector.WithConfig(`
+receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: 0.0.0.0:4317
+
+exporters:
+  debug:
+    verbosity: basic
+
+service:
+  pipelines:
+    metrics:
+      receivers: [otlp]
+      exporters: [debug]
+`))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if _, err := container.OTLPGRPCEndpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := container.DebugOutput(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(output, "Everything is ready") {
+		t.Fatalf("expected the collector to be ready, got %s", output)
+	}
+}
+
+func TestOtelCollectorWithConfigAndConfigFile(t *testing.T) {
+	_, err := otelcollector.RunContainer(context.Background(),
+		otelcollector.WithConfig("receivers: {}"),
+		otelcollector.WithConfigFile(filepath.Join("testdata", "config.yaml")),
+	)
+	if err == nil {
+		t.Fatal("expected an error when combining the WithConfig and WithConfigFile options")
+	}
+}
+
+func post(t *testing.T, url string, body string) {
+	t.Helper()
+
+	resp, err := http.Post(url, "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func waitForDebugOutput(t *testing.T, container *otelcollector.OtelCollectorContainer, expected string) {
+	t.Helper()
+
+	ctx := context.Background()
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		// debugOutput {
+		output, err := container.DebugOutput(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Contains(output, expected) {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %q in the debug output, got %s", expected, output)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+}
@@ -0,0 +1,37 @@
+package pinot_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/pinot"
+)
+
+func ExampleRunContainer() {
+	// runPinotContainer {
+	ctx := context.Background()
+
+	pinotContainer, err := pinot.RunContainer(ctx, testcontainers.WithImage("apachepinot/pinot:1.0.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := pinotContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := pinotContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,227 @@
+package pinot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	controllerPort = "9000/tcp"
+	brokerPort     = "8000/tcp"
+)
+
+// PinotContainer represents the Pinot container type used in the module
+type PinotContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Pinot container type, running the empty quickstart, which starts
+// ZooKeeper, a controller, a broker, a server and a minion in the same container, without any table.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*PinotContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apachepinot/pinot:1.0.0",
+		ExposedPorts: []string{controllerPort, brokerPort},
+		Cmd:          []string{"QuickStart", "-type", "EMPTY"},
+		WaitingFor: wait.ForAll(
+			// the quickstart prints it once all the components are started
+			wait.ForLog("You can always go to http://localhost:9000 to play around in the query console"),
+			wait.ForHTTP("/health").WithPort(controllerPort),
+			wait.ForHTTP("/health").WithPort(brokerPort),
+		).WithDeadline(3 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PinotContainer{Container: container}, nil
+}
+
+// ControllerURL returns the base URL of the controller, e.g. "http://localhost:32768", which serves the
+// admin API to manage the schemas, tables and segments, and the query console.
+func (c *PinotContainer) ControllerURL(ctx context.Context) (string, error) {
+	return c.url(ctx, controllerPort)
+}
+
+// BrokerURL returns the base URL of the broker, e.g. "http://localhost:32768", to be used as the broker
+// address of the Pinot clients.
+func (c *PinotContainer) BrokerURL(ctx context.Context) (string, error) {
+	return c.url(ctx, brokerPort)
+}
+
+// QueryURL returns the URL of the SQL query endpoint of the broker, e.g. "http://localhost:32768/query/sql".
+func (c *PinotContainer) QueryURL(ctx context.Context) (string, error) {
+	brokerURL, err := c.BrokerURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return brokerURL + "/query/sql", nil
+}
+
+// AddSchema adds a schema to the controller, given its JSON definition.
+func (c *PinotContainer) AddSchema(ctx context.Context, schema string) error {
+	_, err := c.do(ctx, controllerPort, http.MethodPost, "/schemas", "application/json", []byte(schema))
+	return err
+}
+
+// AddTable adds a table to the controller, given its JSON config. The schema of the table must be added first.
+func (c *PinotContainer) AddTable(ctx context.Context, tableConfig string) error {
+	_, err := c.do(ctx, controllerPort, http.MethodPost, "/tables", "application/json", []byte(tableConfig))
+	return err
+}
+
+// IngestFromFile builds a segment from the given data and uploads it to an offline table, e.g. "events_OFFLINE",
+// where the format is one of the input formats supported by Pinot, e.g. "csv" or "json". It's meant for small
+// test datasets, as the segment is built by the controller.
+func (c *PinotContainer) IngestFromFile(ctx context.Context, tableNameWithType string, format string, data io.Reader) error {
+	batchConfig, err := json.Marshal(map[string]string{"inputFormat": format})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile("file", "data."+format)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(part, data); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("tableNameWithType", tableNameWithType)
+	query.Set("batchConfigMapStr", string(batchConfig))
+
+	_, err = c.do(ctx, controllerPort, http.MethodPost, "/ingestFromFile?"+query.Encode(), w.FormDataContentType(), body.Bytes())
+	return err
+}
+
+// QueryResult is the result table of a SQL query.
+type QueryResult struct {
+	// ColumnNames are the names of the columns of the result.
+	ColumnNames []string
+	// ColumnDataTypes are the Pinot data types of the columns of the result, e.g. "STRING" or "LONG".
+	ColumnDataTypes []string
+	// Rows are the rows of the result, with a value for each column.
+	Rows [][]interface{}
+}
+
+// Query runs a SQL query on the broker. It returns an error if the broker returns exceptions, e.g. when
+// the table does not exist.
+func (c *PinotContainer) Query(ctx context.Context, sql string) (*QueryResult, error) {
+	reqBody, err := json.Marshal(map[string]string{"sql": sql})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.do(ctx, brokerPort, http.MethodPost, "/query/sql", "application/json", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		ResultTable struct {
+			DataSchema struct {
+				ColumnNames     []string `json:"columnNames"`
+				ColumnDataTypes []string `json:"columnDataTypes"`
+			} `json:"dataSchema"`
+			Rows [][]interface{} `json:"rows"`
+		} `json:"resultTable"`
+		Exceptions []struct {
+			ErrorCode int    `json:"errorCode"`
+			Message   string `json:"message"`
+		} `json:"exceptions"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, 0, len(resp.Exceptions))
+	for _, e := range resp.Exceptions {
+		errs = append(errs, fmt.Errorf("error code %d: %s", e.ErrorCode, e.Message))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &QueryResult{
+		ColumnNames:     resp.ResultTable.DataSchema.ColumnNames,
+		ColumnDataTypes: resp.ResultTable.DataSchema.ColumnDataTypes,
+		Rows:            resp.ResultTable.Rows,
+	}, nil
+}
+
+func (c *PinotContainer) do(ctx context.Context, port nat.Port, method string, path string, contentType string, body []byte) ([]byte, error) {
+	baseURL, err := c.url(ctx, port)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
+
+func (c *PinotContainer) url(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, mappedPort.Port()), nil
+}
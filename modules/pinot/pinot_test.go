@@ -0,0 +1,97 @@
+package pinot_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/pinot"
+)
+
+const (
+	schema = `{
+  "schemaName": "events",
+  "dimensionFieldSpecs": [{"name": "country", "dataType": "STRING"}],
+  "metricFieldSpecs": [{"name": "clicks", "dataType": "LONG"}]
+}`
+
+	tableConfig = `{
+  "tableName": "events",
+  "tableType": "OFFLINE",
+  "segmentsConfig": {"schemaName": "events", "replication": "1"},
+  "tenants": {},
+  "tableIndexConfig": {},
+  "metadata": {}
+}`
+)
+
+func TestPinot(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := pinot.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// controllerURL {
+	controllerURL, err := container.ControllerURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(controllerURL + "/health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the controller health", resp.StatusCode)
+	}
+
+	// addTable {
+	if err := container.AddSchema(ctx, schema); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := container.AddTable(ctx, tableConfig); err != nil {
+		t.Fatal(err)
+	}
+	// }
+
+	// ingestFromFile {
+	data := "country,clicks\nes,3\nfr,5\nes,4\n"
+	if err := container.IngestFromFile(ctx, "events_OFFLINE", "csv", strings.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	// }
+
+	// query {
+	result, err := container.Query(ctx, "SELECT country, SUM(clicks) FROM events GROUP BY country ORDER BY country")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Rows) != 2 {
+		t.Fatalf("expected 2 rows, got %v", result.Rows)
+	}
+
+	if result.Rows[0][0] != "es" || result.Rows[0][1] != 7.0 {
+		t.Fatalf("expected 7 clicks for es, got %v", result.Rows[0])
+	}
+
+	_, err = container.Query(ctx, "SELECT * FROM missing")
+	if err == nil {
+		t.Fatal("expected an error for a missing table")
+	}
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// SSLSettings is the configuration of the server certificates, used when SSL is enabled.
+	SSLSettings *SSLSettings
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Postgres container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// SSLSettings holds the paths, in the host, of the PEM encoded files used to enable SSL in the Postgres server.
+type SSLSettings struct {
+	// CACertFile is the path to the certificate of the CA that signed the server certificate.
+	CACertFile string
+	// CertFile is the path to the server certificate.
+	CertFile string
+	// KeyFile is the path to the private key of the server certificate.
+	KeyFile string
+}
+
+// WithSSLSettings enables SSL in the Postgres server, using the given CA certificate, server
+// certificate and key. The files are copied into the container, and the server is started
+// with ssl=on. Use the TLSConfig method of the container to get a client configuration
+// that trusts the server certificate.
+func WithSSLSettings(sslSettings SSLSettings) Option {
+	return func(o *options) {
+		o.SSLSettings = &sslSettings
+	}
+}
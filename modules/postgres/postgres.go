@@ -2,34 +2,61 @@ package postgres
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/wait"
 )
 
 const (
 	defaultUser          = "postgres"
 	defaultPassword      = "postgres"
 	defaultPostgresImage = "docker.io/postgres:11-alpine"
+	defaultPgvectorImage = "docker.io/pgvector/pgvector:pg16"
+	defaultSnapshotName  = "migrated_template"
+
+	sslDir              = "/tmp/testcontainers-go/postgres"
+	sslCACertFile       = sslDir + "/ca_cert.pem"
+	sslCertFile         = sslDir + "/server.cert"
+	sslKeyFile          = sslDir + "/server.key"
+	defaultPostgresPort = "5432/tcp"
 )
 
+// sslEntrypoint wraps the original entrypoint of the image, handing over the certificates
+// to the postgres user, as they are copied into the container as root and the server
+// refuses to use a private key that is not owned by the database user.
+const sslEntrypoint = `set -e
+chown postgres:postgres ` + sslCACertFile + " " + sslCertFile + " " + sslKeyFile + `
+chmod 600 ` + sslKeyFile + `
+exec docker-entrypoint.sh "$@"`
+
 // PostgresContainer represents the postgres container type used in the module
 type PostgresContainer struct {
 	testcontainers.Container
-	dbName   string
-	user     string
-	password string
+	dbName       string
+	user         string
+	password     string
+	snapshotName string
+	caCertFile   string
 }
 
 // ConnectionString returns the connection string for the postgres container, using the default 5432 port, and
 // obtaining the host and exposed port from the container. It also accepts a variadic list of extra arguments
 // which will be appended to the connection string. The format of the extra arguments is the same as the
-// connection string format, e.g. "connect_timeout=10" or "application_name=myapp"
+// connection string format, e.g. "connect_timeout=10" or "application_name=myapp".
+// The user and password are escaped, so they can contain any character.
 func (c *PostgresContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
-	containerPort, err := c.MappedPort(ctx, "5432/tcp")
+	containerPort, err := c.MappedPort(ctx, defaultPostgresPort)
 	if err != nil {
 		return "", err
 	}
@@ -39,9 +66,20 @@ func (c *PostgresContainer) ConnectionString(ctx context.Context, args ...string
 		return "", err
 	}
 
-	extraArgs := strings.Join(args, "&")
-	connStr := fmt.Sprintf("postgres://%s:%s@%s/%s?%s", c.user, c.password, net.JoinHostPort(host, containerPort.Port()), c.dbName, extraArgs)
-	return connStr, nil
+	return buildConnectionString(c.user, c.password, net.JoinHostPort(host, containerPort.Port()), c.dbName, args...), nil
+}
+
+// buildConnectionString assembles a URL connection string, appending the extra arguments as query parameters.
+func buildConnectionString(user string, password string, hostPort string, dbName string, args ...string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     hostPort,
+		Path:     "/" + dbName,
+		RawQuery: strings.Join(args, "&"),
+	}
+
+	return u.String()
 }
 
 // WithConfigFile sets the config file to be used for the postgres container
@@ -69,7 +107,10 @@ func WithDatabase(dbName string) testcontainers.CustomizeRequestOption {
 	}
 }
 
-// WithInitScripts sets the init scripts to be run when the container starts
+// WithInitScripts sets the init scripts to be run when the container starts.
+// The scripts are copied into the /docker-entrypoint-initdb.d directory of the container,
+// so they must be either SQL (.sql, .sql.gz) or shell (.sh) scripts, and they are executed
+// in alphabetical order, only the first time the database is initialised.
 func WithInitScripts(scripts ...string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		initScripts := []testcontainers.ContainerFile{}
@@ -85,6 +126,52 @@ func WithInitScripts(scripts ...string) testcontainers.CustomizeRequestOption {
 	}
 }
 
+// WithExtension creates the given extensions, and the ones they depend on, in the database of the container,
+// once it's ready according to the wait strategy, which must wait for the server to accept connections.
+// The extensions must be available in the image, e.g. "timescaledb" in the timescale/timescaledb images,
+// or "vector" in the pgvector/pgvector images. It can be called multiple times.
+func WithExtension(names ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		cmds := make([]string, 0, len(names))
+		for _, name := range names {
+			cmds = append(cmds, fmt.Sprintf(`CREATE EXTENSION IF NOT EXISTS %s CASCADE`, quoteIdentifier(name)))
+		}
+
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					// the user and the database are read from the environment of the container,
+					// as they are set by the options applied after this one.
+					for _, cmd := range cmds {
+						err := execSQL(ctx, c, []string{"sh", "-c", `psql -v ON_ERROR_STOP=1 -U "$POSTGRES_USER" -d "$POSTGRES_DB" -c "$1"`, "sh", cmd})
+						if err != nil {
+							return fmt.Errorf("failed to create extension: %w", err)
+						}
+					}
+
+					return nil
+				},
+			},
+		})
+	}
+}
+
+// WithPgvector runs the pgvector/pgvector image, creating the "vector" extension once the container is ready,
+// for vector similarity search tests. If no wait strategy is set before this option, it waits for the server
+// to accept connections. A different version of the image can be set with testcontainers.WithImage after this option.
+func WithPgvector() testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Image = defaultPgvectorImage
+		WithExtension("vector")(req)
+
+		if req.WaitingFor == nil {
+			req.WaitingFor = wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(time.Minute)
+		}
+	}
+}
+
 // WithPassword sets the initial password of the user to be created when the container starts
 // It is required for you to use the PostgreSQL image. It must not be empty or undefined.
 // This environment variable sets the superuser password for PostgreSQL.
@@ -117,7 +204,7 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 			"POSTGRES_PASSWORD": defaultPassword,
 			"POSTGRES_DB":       defaultUser, // defaults to the user name
 		},
-		ExposedPorts: []string{"5432/tcp"},
+		ExposedPorts: []string{defaultPostgresPort},
 		Cmd:          []string{"postgres", "-c", "fsync=off"},
 	}
 
@@ -126,10 +213,20 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		Started:          true,
 	}
 
+	settings := defaultOptions()
 	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
 		opt.Customize(&genericContainerReq)
 	}
 
+	if settings.SSLSettings != nil {
+		if err := configureSSL(&genericContainerReq, *settings.SSLSettings); err != nil {
+			return nil, err
+		}
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
 	if err != nil {
 		return nil, err
@@ -139,5 +236,178 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	password := req.Env["POSTGRES_PASSWORD"]
 	dbName := req.Env["POSTGRES_DB"]
 
-	return &PostgresContainer{Container: container, dbName: dbName, password: password, user: user}, nil
+	pgContainer := &PostgresContainer{Container: container, dbName: dbName, password: password, user: user, snapshotName: defaultSnapshotName}
+	if settings.SSLSettings != nil {
+		pgContainer.caCertFile = settings.SSLSettings.CACertFile
+	}
+
+	return pgContainer, nil
+}
+
+// configureSSL copies the certificates into the container and starts the server with SSL enabled.
+func configureSSL(req *testcontainers.GenericContainerRequest, sslSettings SSLSettings) error {
+	if sslSettings.CACertFile == "" || sslSettings.CertFile == "" || sslSettings.KeyFile == "" {
+		return fmt.Errorf("the CA certificate, the server certificate and the key are required to enable SSL")
+	}
+
+	req.Files = append(req.Files,
+		testcontainers.ContainerFile{HostFilePath: sslSettings.CACertFile, ContainerFilePath: sslCACertFile, FileMode: 0o600},
+		testcontainers.ContainerFile{HostFilePath: sslSettings.CertFile, ContainerFilePath: sslCertFile, FileMode: 0o600},
+		testcontainers.ContainerFile{HostFilePath: sslSettings.KeyFile, ContainerFilePath: sslKeyFile, FileMode: 0o600},
+	)
+
+	// the command is passed as positional arguments to the wrapper script, which are
+	// forwarded to the original entrypoint. The first argument is the script name.
+	req.Entrypoint = []string{"sh", "-c", sslEntrypoint, "sh"}
+	req.Cmd = append(req.Cmd,
+		"-c", "ssl=on",
+		"-c", "ssl_ca_file="+sslCACertFile,
+		"-c", "ssl_cert_file="+sslCertFile,
+		"-c", "ssl_key_file="+sslKeyFile,
+	)
+
+	return nil
+}
+
+// TLSConfig returns a client TLS configuration that trusts the CA certificate passed with the
+// WithSSLSettings option, and verifies the server certificate against the container host,
+// so it can be used to test code paths that require sslmode=verify-full.
+func (c *PostgresContainer) TLSConfig(ctx context.Context) (*tls.Config, error) {
+	if c.caCertFile == "" {
+		return nil, fmt.Errorf("SSL is not enabled, use the WithSSLSettings option to enable it")
+	}
+
+	caCert, err := os.ReadFile(c.caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse CA certificate %s", c.caCertFile)
+	}
+
+	host, err := c.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		RootCAs:    caCertPool,
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+type snapshotConfig struct {
+	snapshotName string
+}
+
+// SnapshotOption is a type that can be used to configure the snapshot of the database
+type SnapshotOption func(*snapshotConfig)
+
+// WithSnapshotName sets the name of the template database used to store the snapshot.
+// If it is not specified, then "migrated_template" will be used.
+func WithSnapshotName(name string) SnapshotOption {
+	return func(cfg *snapshotConfig) {
+		cfg.snapshotName = name
+	}
+}
+
+// Snapshot takes a snapshot of the current state of the database as a template database,
+// which can be used to restore the database to this state later on with the Restore method.
+// Any existing connection to the database is terminated, as Postgres requires that no other
+// sessions are connected to a database while it is being copied.
+func (c *PostgresContainer) Snapshot(ctx context.Context, opts ...SnapshotOption) error {
+	snapshotName := c.snapshotNameFrom(opts)
+
+	err := c.execCommandsSQL(ctx,
+		// the snapshot could already exist, as a template database, if Snapshot is called more than once
+		fmt.Sprintf(`UPDATE pg_database SET datistemplate = FALSE WHERE datname = %s`, quoteLiteral(snapshotName)),
+		fmt.Sprintf(`DROP DATABASE IF EXISTS %s`, quoteIdentifier(snapshotName)),
+		terminateConnectionsSQL(c.dbName),
+		fmt.Sprintf(`CREATE DATABASE %s WITH TEMPLATE %s OWNER %s`, quoteIdentifier(snapshotName), quoteIdentifier(c.dbName), quoteIdentifier(c.user)),
+		fmt.Sprintf(`ALTER DATABASE %s WITH is_template = TRUE`, quoteIdentifier(snapshotName)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot database %s: %w", c.dbName, err)
+	}
+
+	c.snapshotName = snapshotName
+	return nil
+}
+
+// Restore restores the database to the state of a snapshot previously taken with the Snapshot method.
+// Creating a database from a template is a file level copy, so it is usually much faster than
+// recreating the container and running the init scripts again. Any existing connection to the
+// database is terminated, so clients must reconnect after the database has been restored.
+func (c *PostgresContainer) Restore(ctx context.Context, opts ...SnapshotOption) error {
+	snapshotName := c.snapshotNameFrom(opts)
+
+	err := c.execCommandsSQL(ctx,
+		terminateConnectionsSQL(c.dbName),
+		fmt.Sprintf(`DROP DATABASE %s`, quoteIdentifier(c.dbName)),
+		fmt.Sprintf(`CREATE DATABASE %s WITH TEMPLATE %s OWNER %s`, quoteIdentifier(c.dbName), quoteIdentifier(snapshotName), quoteIdentifier(c.user)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore database %s from snapshot %s: %w", c.dbName, snapshotName, err)
+	}
+
+	return nil
+}
+
+// snapshotNameFrom returns the snapshot name from the options, falling back to the last one used.
+func (c *PostgresContainer) snapshotNameFrom(opts []SnapshotOption) string {
+	cfg := snapshotConfig{snapshotName: c.snapshotName}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg.snapshotName
+}
+
+// execCommandsSQL runs the given SQL commands in order, using psql inside the container.
+// The commands are run while connected to the template1 database, so that the database of
+// the container can be dropped and recreated.
+func (c *PostgresContainer) execCommandsSQL(ctx context.Context, cmds ...string) error {
+	for _, cmd := range cmds {
+		if err := execSQL(ctx, c, []string{"psql", "-v", "ON_ERROR_STOP=1", "-U", c.user, "-d", "template1", "-c", cmd}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execSQL runs the given psql command inside the container, whose last argument is the SQL command,
+// returning its output as error if it fails.
+func execSQL(ctx context.Context, c testcontainers.Container, psqlCmd []string) error {
+	code, reader, err := c.Exec(ctx, psqlCmd, exec.Multiplexed())
+	if err != nil {
+		return err
+	}
+
+	if code != 0 {
+		out, _ := io.ReadAll(reader)
+		return fmt.Errorf("non-zero exit code %d running %q: %s", code, psqlCmd[len(psqlCmd)-1], string(out))
+	}
+
+	return nil
+}
+
+// terminateConnectionsSQL returns the SQL command to terminate all the sessions connected to the given database.
+func terminateConnectionsSQL(dbName string) string {
+	return fmt.Sprintf(`SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = %s AND pid <> pg_backend_pid()`, quoteLiteral(dbName))
+}
+
+// quoteIdentifier quotes the given identifier, e.g. a database name, to be used in a SQL command,
+// doubling the double quotes it contains.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteLiteral quotes the given string literal to be used in a SQL command,
+// doubling the single quotes it contains.
+func quoteLiteral(value string) string {
+	return `'` + strings.ReplaceAll(value, `'`, `''`) + `'`
 }
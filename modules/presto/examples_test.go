@@ -0,0 +1,37 @@
+package presto_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/presto"
+)
+
+func ExampleRunContainer() {
+	// runPrestoContainer {
+	ctx := context.Background()
+
+	prestoContainer, err := presto.RunContainer(ctx, testcontainers.WithImage("prestodb/presto:0.285.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := prestoContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := prestoContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,156 @@
+package presto_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/presto"
+)
+
+func TestPresto(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := presto.RunContainer(ctx, presto.WithCatalog("tpch", map[string]string{"connector.name": "tpch"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx, "catalog=tpch", "schema=tiny")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := query(connStr, "SELECT count(*) FROM nation")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0][0] != 25.0 {
+		t.Fatalf("expected 25 nations, got %v", rows)
+	}
+}
+
+func TestPrestoWithCatalogs(t *testing.T) {
+	ctx := context.Background()
+
+	// withCatalogs {
+	container, err := presto.RunContainer(ctx,
+		presto.WithCatalog("tpch", map[string]string{"connector.name": "tpch"}),
+		presto.WithCatalog("mem", map[string]string{"connector.name": "memory"}),
+		presto.WithCatalogFile(filepath.Join("testdata", "blackhole.properties")),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a federated query, joining a table of the memory catalog with a table of the tpch catalog
+	for _, q := range []string{
+		"CREATE TABLE mem.default.regions AS SELECT regionkey, name FROM tpch.tiny.region",
+		"CREATE TABLE blackhole.default.sink (id BIGINT)",
+	} {
+		if _, err := query(connStr, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := query(connStr, `SELECT count(*) FROM tpch.tiny.nation n JOIN mem.default.regions r ON n.regionkey = r.regionkey`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 1 || rows[0][0] != 25.0 {
+		t.Fatalf("expected 25 nations, got %v", rows)
+	}
+}
+
+func TestPrestoWithInvalidCatalog(t *testing.T) {
+	_, err := presto.RunContainer(context.Background(), presto.WithCatalog("mem", map[string]string{}))
+	if err == nil {
+		t.Fatal("expected an error for a catalog without connector.name")
+	}
+}
+
+// query runs the query with the client REST API of Presto, following the next URIs until the query
+// completes, which avoids a dependency on a Presto driver. The user, catalog and schema are read from
+// the connection string.
+func query(connStr string, q string) ([][]interface{}, error) {
+	u, err := url.Parse(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.Scheme+"://"+u.Host+"/v1/statement", strings.NewReader(q))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Presto-User", u.User.Username())
+	if catalog := u.Query().Get("catalog"); catalog != "" {
+		req.Header.Set("X-Presto-Catalog", catalog)
+	}
+	if schema := u.Query().Get("schema"); schema != "" {
+		req.Header.Set("X-Presto-Schema", schema)
+	}
+
+	var rows [][]interface{}
+	for req != nil {
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			NextURI string          `json:"nextUri"`
+			Data    [][]interface{} `json:"data"`
+			Error   *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Error != nil {
+			return nil, errors.New(result.Error.Message)
+		}
+
+		rows = append(rows, result.Data...)
+
+		req = nil
+		if result.NextURI != "" {
+			if req, err = http.NewRequest(http.MethodGet, result.NextURI, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,37 @@
+package prometheus_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/prometheus"
+)
+
+func ExampleRunContainer() {
+	// runPrometheusContainer {
+	ctx := context.Background()
+
+	prometheusContainer, err := prometheus.RunContainer(ctx, testcontainers.WithImage("prom/prometheus:v2.49.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := prometheusContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := prometheusContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
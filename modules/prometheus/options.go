@@ -0,0 +1,59 @@
+package prometheus
+
+import (
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Config is the content of the prometheus.yml file.
+	Config string
+	// ScrapeConfigs is the list of scrape configs of the generated prometheus.yml file.
+	ScrapeConfigs []ScrapeConfig
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Prometheus container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// ScrapeConfig is a scrape config of the generated prometheus.yml file, scraping static targets.
+type ScrapeConfig struct {
+	// JobName is the name of the job, set as the "job" label of the scraped series.
+	JobName string
+	// Targets are the "host:port" addresses to scrape. The containers attached to the same network
+	// as Prometheus can be scraped using their network aliases, e.g. "app:8080".
+	Targets []string
+	// MetricsPath is the HTTP path of the metrics. Defaults to "/metrics".
+	MetricsPath string
+	// Interval is the scrape interval. Defaults to one minute, so tests usually set a shorter one.
+	Interval time.Duration
+}
+
+// WithConfig sets the content of the prometheus.yml file, e.g. a file generated by the test.
+// It cannot be combined with WithScrapeConfig.
+func WithConfig(config string) Option {
+	return func(o *options) {
+		o.Config = config
+	}
+}
+
+// WithScrapeConfig adds scrape configs to the prometheus.yml file generated by the module.
+// It can be called multiple times, and it cannot be combined with WithConfig. To scrape other
+// containers by their network aliases, attach Prometheus to their network, e.g. with network.WithNetwork.
+func WithScrapeConfig(scrapeConfigs ...ScrapeConfig) Option {
+	return func(o *options) {
+		o.ScrapeConfigs = append(o.ScrapeConfigs, scrapeConfigs...)
+	}
+}
@@ -0,0 +1,138 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "9090/tcp"
+
+	configPath = "/etc/prometheus/prometheus.yml"
+)
+
+// PrometheusContainer represents the Prometheus container type used in the module
+type PrometheusContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Prometheus container type. The configuration is either
+// the one set with the WithConfig option, or the one generated from the WithScrapeConfig options,
+// or the default configuration of the image, scraping Prometheus itself.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*PrometheusContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "prom/prometheus:v2.49.1",
+		ExposedPorts: []string{httpPort},
+		WaitingFor:   wait.ForHTTP("/-/ready").WithPort(httpPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.Config != "" && len(settings.ScrapeConfigs) > 0 {
+		return nil, errors.New("the WithConfig and WithScrapeConfig options cannot be combined")
+	}
+
+	config := settings.Config
+	if len(settings.ScrapeConfigs) > 0 {
+		rendered, err := renderConfig(settings.ScrapeConfigs)
+		if err != nil {
+			return nil, err
+		}
+		config = rendered
+	}
+
+	if config != "" {
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, []byte(config), configPath, 0o644)
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrometheusContainer{Container: container}, nil
+}
+
+// renderConfig returns the content of a prometheus.yml file with the given scrape configs.
+func renderConfig(scrapeConfigs []ScrapeConfig) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("scrape_configs:\n")
+
+	for _, sc := range scrapeConfigs {
+		if sc.JobName == "" {
+			return "", errors.New("the job name of the scrape config is required")
+		}
+
+		if len(sc.Targets) == 0 {
+			return "", fmt.Errorf("the scrape config %s has no targets", sc.JobName)
+		}
+
+		sb.WriteString(fmt.Sprintf("  - job_name: %q\n", sc.JobName))
+		if sc.MetricsPath != "" {
+			sb.WriteString(fmt.Sprintf("    metrics_path: %q\n", sc.MetricsPath))
+		}
+		if sc.Interval > 0 {
+			sb.WriteString(fmt.Sprintf("    scrape_interval: %s\n", sc.Interval))
+		}
+
+		targets := make([]string, 0, len(sc.Targets))
+		for _, target := range sc.Targets {
+			targets = append(targets, fmt.Sprintf("%q", target))
+		}
+
+		sb.WriteString("    static_configs:\n")
+		sb.WriteString("      - targets: [" + strings.Join(targets, ", ") + "]\n")
+	}
+
+	return sb.String(), nil
+}
+
+// URL returns the base URL of Prometheus, e.g. "http://localhost:32768", to be used as the address
+// of the Prometheus API clients, or as a Prometheus data source.
+func (c *PrometheusContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// QueryURL returns the URL of the instant query endpoint of the HTTP API, e.g. "http://localhost:32768/api/v1/query",
+// accepting PromQL queries in the "query" parameter.
+func (c *PrometheusContainer) QueryURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v1/query", nil
+}
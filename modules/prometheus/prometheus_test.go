@@ -0,0 +1,151 @@
+package prometheus_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/prometheus"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func TestPrometheus(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	// withScrapeConfig {
+	container, err := prometheus.RunContainer(ctx,
+		network.WithNetwork([]string{"prometheus"}, nw),
+		prometheus.WithScrapeConfig(prometheus.ScrapeConfig{
+			JobName: "self",
+			// Prometheus scrapes itself through its network alias
+			Targets:  []string{"prometheus:9090"},
+			Interval: time.Second,
+		}),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// queryURL {
+	queryURL, err := container.QueryURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the target is up once it has been scraped
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		value, err := queryValue(queryURL, `up{job="self"}`)
+		if err == nil && value == "1" {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the target to be up, got %q: %v", value, err)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func TestPrometheusWithConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// withConfig {
+	container, err := prometheus.RunContainer(ctx, prometheus.WithConfig(`
+global:
+  external_labels:
+    environment: test
+`))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	baseURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(baseURL + "/api/v1/status/config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			YAML string `json:"yaml"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(result.Data.YAML, "environment: test") {
+		t.Fatalf("expected the external labels in the configuration, got %s", result.Data.YAML)
+	}
+}
+
+func TestPrometheusWithInvalidScrapeConfig(t *testing.T) {
+	_, err := prometheus.RunContainer(context.Background(), prometheus.WithScrapeConfig(prometheus.ScrapeConfig{JobName: "empty"}))
+	if err == nil {
+		t.Fatal("expected an error for a scrape config without targets")
+	}
+}
+
+// queryValue runs an instant query, returning the value of the first sample.
+func queryValue(queryURL string, query string) (string, error) {
+	resp, err := http.Get(queryURL + "?query=" + url.QueryEscape(query))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			Result []struct {
+				Value []interface{} `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	if len(result.Data.Result) == 0 || len(result.Data.Result[0].Value) != 2 {
+		return "", nil
+	}
+
+	value, _ := result.Data.Result[0].Value[1].(string)
+	return value, nil
+}
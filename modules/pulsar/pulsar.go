@@ -35,10 +35,13 @@ type Container struct {
 	LogConsumers []testcontainers.LogConsumer // Deprecated. Use the ContainerRequest instead. Needs to be exported to control the stop from the caller
 }
 
+// BrokerURL returns the URL of the Pulsar broker, in the format pulsar://host:port,
+// to be used by the Pulsar clients.
 func (c *Container) BrokerURL(ctx context.Context) (string, error) {
 	return c.resolveURL(ctx, defaultPulsarPort)
 }
 
+// HTTPServiceURL returns the URL of the Pulsar admin API, in the format http://host:port.
 func (c *Container) HTTPServiceURL(ctx context.Context) (string, error) {
 	return c.resolveURL(ctx, defaultPulsarAdminPort)
 }
@@ -74,14 +77,19 @@ func WithFunctionsWorker() testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		req.Cmd = []string{"/bin/bash", "-c", defaultPulsarCmd}
 
-		ss := []wait.Strategy{
-			wait.ForLog("Function worker service started"),
-		}
-
-		ss = append(ss, defaultWaitStrategies.Strategies...)
+		addWaitStrategy(req, wait.ForLog("Function worker service started"))
+	}
+}
 
-		req.WaitingFor = wait.ForAll(ss...)
+// addWaitStrategy adds the given strategy to the current one of the request, so that
+// options like WithFunctionsWorker and WithTransactions can be combined.
+func addWaitStrategy(req *testcontainers.GenericContainerRequest, s wait.Strategy) {
+	if req.WaitingFor == nil {
+		req.WaitingFor = wait.ForAll(s)
+		return
 	}
+
+	req.WaitingFor = wait.ForAll(s, req.WaitingFor)
 }
 
 // Deprecated: use the testcontainers.WithLogConsumers functional option instead
@@ -105,20 +113,15 @@ func WithPulsarEnv(configVar string, configValue string) testcontainers.Customiz
 	}
 }
 
+// WithTransactions enables the transaction coordinator, and adds a waiting strategy
+// for the transaction coordinator topic to be created.
 func WithTransactions() testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		WithPulsarEnv("transactionCoordinatorEnabled", "true")(req)
 
-		// clone defaultWaitStrategies
-		ss := []wait.Strategy{
-			wait.ForHTTP(transactionTopicEndpoint).WithPort(defaultPulsarAdminPort).WithStatusCodeMatcher(func(statusCode int) bool {
-				return statusCode == 200
-			}),
-		}
-
-		ss = append(ss, defaultWaitStrategies.Strategies...)
-
-		req.WaitingFor = wait.ForAll(ss...)
+		addWaitStrategy(req, wait.ForHTTP(transactionTopicEndpoint).WithPort(defaultPulsarAdminPort).WithStatusCodeMatcher(func(statusCode int) bool {
+			return statusCode == 200
+		}))
 	}
 }
 
@@ -77,6 +77,13 @@ func TestPulsar(t *testing.T) {
 				// }
 			},
 		},
+		{
+			name: "with functions worker and transactions",
+			opts: []testcontainers.ContainerCustomizer{
+				testcontainerspulsar.WithFunctionsWorker(),
+				testcontainerspulsar.WithTransactions(),
+			},
+		},
 		{
 			name: "with log consumers",
 			opts: []testcontainers.ContainerCustomizer{
@@ -0,0 +1,20 @@
+package qdrant
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// WithAPIKey sets the API key required by the REST and gRPC endpoints, passed by the
+// clients in the "api-key" header, or as the APIKey of the Qdrant Go client.
+func WithAPIKey(apiKey string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["QDRANT__SERVICE__API_KEY"] = apiKey
+	}
+}
+
+// WithReadOnlyAPIKey sets an API key with read-only access to the REST and gRPC endpoints.
+func WithReadOnlyAPIKey(apiKey string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.Env["QDRANT__SERVICE__READ_ONLY_API_KEY"] = apiKey
+	}
+}
@@ -0,0 +1,87 @@
+package qdrant
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	restPort = "6333/tcp"
+	grpcPort = "6334/tcp"
+)
+
+// QdrantContainer represents the Qdrant container type used in the module
+type QdrantContainer struct {
+	testcontainers.Container
+	apiKey string
+}
+
+// RunContainer creates an instance of the Qdrant container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*QdrantContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "qdrant/qdrant:v1.7.4",
+		ExposedPorts: []string{restPort, grpcPort},
+		Env:          map[string]string{},
+		WaitingFor: wait.ForAll(
+			// the health endpoints do not require the API key
+			wait.ForHTTP("/readyz").WithPort(restPort),
+			wait.ForListeningPort(grpcPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QdrantContainer{Container: container, apiKey: genericContainerReq.Env["QDRANT__SERVICE__API_KEY"]}, nil
+}
+
+// RESTEndpoint returns the URL of the REST API, e.g. "http://localhost:32768".
+func (c *QdrantContainer) RESTEndpoint(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", address), nil
+}
+
+// GRPCEndpoint returns the "host:port" address of the gRPC API, to be used by the Qdrant Go client.
+func (c *QdrantContainer) GRPCEndpoint(ctx context.Context) (string, error) {
+	return c.address(ctx, grpcPort)
+}
+
+// APIKey returns the API key set with the WithAPIKey option, or an empty string if the API key is not required.
+func (c *QdrantContainer) APIKey() string {
+	return c.apiKey
+}
+
+func (c *QdrantContainer) address(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
@@ -0,0 +1,107 @@
+package qdrant
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestQdrant(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("qdrant/qdrant:v1.7.4"))
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	t.Run("rest", func(t *testing.T) {
+		// restEndpoint {
+		restEndpoint, err := container.RESTEndpoint(ctx)
+		// }
+		require.NoError(t, err)
+
+		status := doRequest(t, http.MethodPut, restEndpoint+"/collections/test_collection", `{"vectors": {"size": 4, "distance": "Dot"}}`, "")
+		assert.Equal(t, http.StatusOK, status)
+
+		status = doRequest(t, http.MethodGet, restEndpoint+"/collections/test_collection", "", "")
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		// grpcEndpoint {
+		grpcEndpoint, err := container.GRPCEndpoint(ctx)
+		// }
+		require.NoError(t, err)
+		assert.NotEmpty(t, grpcEndpoint)
+	})
+
+	assert.Empty(t, container.APIKey())
+}
+
+func TestQdrantWithAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	// withAPIKey {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("qdrant/qdrant:v1.7.4"),
+		WithAPIKey("s3cr3t"),
+		WithReadOnlyAPIKey("r34d0nly"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	assert.Equal(t, "s3cr3t", container.APIKey())
+
+	restEndpoint, err := container.RESTEndpoint(ctx)
+	require.NoError(t, err)
+
+	t.Run("without-api-key", func(t *testing.T) {
+		status := doRequest(t, http.MethodGet, restEndpoint+"/collections", "", "")
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("with-api-key", func(t *testing.T) {
+		status := doRequest(t, http.MethodPut, restEndpoint+"/collections/test_collection", `{"vectors": {"size": 4, "distance": "Dot"}}`, container.APIKey())
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("with-read-only-api-key", func(t *testing.T) {
+		status := doRequest(t, http.MethodGet, restEndpoint+"/collections", "", "r34d0nly")
+		assert.Equal(t, http.StatusOK, status)
+
+		status = doRequest(t, http.MethodPut, restEndpoint+"/collections/other_collection", `{"vectors": {"size": 4, "distance": "Dot"}}`, "r34d0nly")
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+}
+
+func doRequest(t *testing.T, method string, url string, body string, apiKey string) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("api-key", apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
@@ -0,0 +1,37 @@
+package questdb_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/questdb"
+)
+
+func ExampleRunContainer() {
+	// runQuestDBContainer {
+	ctx := context.Background()
+
+	questdbContainer, err := questdb.RunContainer(ctx, testcontainers.WithImage("questdb/questdb:7.3.9"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := questdbContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := questdbContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,103 @@
+package questdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/questdb"
+)
+
+func TestQuestDB(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := questdb.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// ilpAddress {
+	ilpAddress, err := container.ILPAddress(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.Dial("tcp", ilpAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.Write([]byte("sensors,location=north temperature=21.5\nsensors,location=south temperature=23.1\n"))
+	conn.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// restURL {
+	restURL, err := container.RESTURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the rows are committed asynchronously, so the query is retried until they are visible
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		count, err := countRows(restURL, "sensors")
+		if err == nil && count == 2 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 2 rows, got %d: %v", count, err)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(connStr, "postgres://admin:quest@") {
+		t.Fatalf("expected the default credentials in the connection string, got %s", connStr)
+	}
+}
+
+// countRows returns the number of rows of the given table, queried through the REST API.
+func countRows(restURL string, table string) (int, error) {
+	resp, err := http.Get(restURL + "/exec?query=" + url.QueryEscape("SELECT count() FROM "+table))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Dataset [][]int `json:"dataset"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+
+	if len(result.Dataset) == 0 || len(result.Dataset[0]) == 0 {
+		return 0, nil
+	}
+
+	return result.Dataset[0][0], nil
+}
@@ -12,3 +12,8 @@ ssl_options.depth = {{ .SSLSettings.VerificationDepth }}
 ssl_options.verify = {{ .SSLSettings.VerificationMode }}
 ssl_options.fail_if_no_peer_cert = {{ .SSLSettings.FailIfNoCert }}
 {{- end }}
+
+{{- if .DefinitionsFile }}
+definitions.import_backend = local_filesystem
+definitions.local.path = {{ .DefinitionsFile }}
+{{- end }}
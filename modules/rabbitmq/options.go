@@ -13,6 +13,13 @@ type options struct {
 	AdminUsername string
 	AdminPassword string
 	SSLSettings   *SSLSettings
+	// Plugins is the list of plugins to be enabled once the node is ready.
+	Plugins []string
+	// DefinitionsFile is the path to the definitions file inside the container,
+	// which is imported by the node on boot. It's empty if no definitions are loaded.
+	DefinitionsFile string
+	// definitionsHostPath is the path to the definitions file in the host.
+	definitionsHostPath string
 }
 
 func defaultOptions() options {
@@ -68,3 +75,24 @@ func WithSSL(settings SSLSettings) Option {
 		o.SSLSettings = &settings
 	}
 }
+
+// WithPluginsEnabled enables the given plugins, e.g. "rabbitmq_mqtt" or "rabbitmq_shovel",
+// right after the node is ready, using the rabbitmq-plugins command inside the container.
+// It can be called multiple times.
+func WithPluginsEnabled(plugins ...string) Option {
+	return func(o *options) {
+		o.Plugins = append(o.Plugins, plugins...)
+	}
+}
+
+// WithDefinitions imports the definitions file in the given host path when the node boots,
+// declaring the users, virtual hosts, permissions, exchanges, queues, bindings and policies in it.
+// The file can be exported from an existing node with the management API or with rabbitmqctl.
+// Please note that if the definitions include users, the default admin user is not created.
+// Reference: https://www.rabbitmq.com/definitions.html
+func WithDefinitions(hostPath string) Option {
+	return func(o *options) {
+		o.DefinitionsFile = defaultDefinitionsPath
+		o.definitionsHostPath = hostPath
+	}
+}
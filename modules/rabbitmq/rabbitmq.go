@@ -5,6 +5,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"text/template"
@@ -13,17 +14,19 @@ import (
 	"github.com/docker/go-connections/nat"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
 const (
-	DefaultAMQPSPort      = "5671/tcp"
-	DefaultAMQPPort       = "5672/tcp"
-	DefaultHTTPSPort      = "15671/tcp"
-	DefaultHTTPPort       = "15672/tcp"
-	defaultPassword       = "guest"
-	defaultUser           = "guest"
-	defaultCustomConfPath = "/etc/rabbitmq/rabbitmq-testcontainers.conf"
+	DefaultAMQPSPort       = "5671/tcp"
+	DefaultAMQPPort        = "5672/tcp"
+	DefaultHTTPSPort       = "15671/tcp"
+	DefaultHTTPPort        = "15672/tcp"
+	defaultPassword        = "guest"
+	defaultUser            = "guest"
+	defaultCustomConfPath  = "/etc/rabbitmq/rabbitmq-testcontainers.conf"
+	defaultDefinitionsPath = "/etc/rabbitmq/definitions.json"
 )
 
 //go:embed mounts/rabbitmq-testcontainers.conf.tpl
@@ -46,9 +49,9 @@ func (c *RabbitMQContainer) AmqpURL(ctx context.Context) (string, error) {
 	return fmt.Sprintf("amqp://%s:%s@%s", c.AdminUsername, c.AdminPassword, endpoint), nil
 }
 
-// AmqpURL returns the URL for AMQPS clients.
+// AmqpsURL returns the URL for AMQPS clients.
 func (c *RabbitMQContainer) AmqpsURL(ctx context.Context) (string, error) {
-	endpoint, err := c.PortEndpoint(ctx, nat.Port(DefaultAMQPPort), "")
+	endpoint, err := c.PortEndpoint(ctx, nat.Port(DefaultAMQPSPort), "")
 	if err != nil {
 		return "", err
 	}
@@ -107,6 +110,18 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		applySSLSettings(settings.SSLSettings)(&genericContainerReq)
 	}
 
+	if settings.definitionsHostPath != "" {
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      settings.definitionsHostPath,
+			ContainerFilePath: settings.DefinitionsFile,
+			FileMode:          0o644,
+		})
+	}
+
+	if len(settings.Plugins) > 0 {
+		enablePlugins(settings.Plugins)(&genericContainerReq)
+	}
+
 	nodeConfig, err := renderRabbitMQConfig(settings)
 	if err != nil {
 		return nil, err
@@ -177,6 +192,31 @@ func applySSLSettings(sslSettings *SSLSettings) testcontainers.CustomizeRequestO
 	}
 }
 
+// enablePlugins adds a post-start hook enabling the given plugins once the node is ready.
+func enablePlugins(plugins []string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					cmd := append([]string{"rabbitmq-plugins", "enable"}, plugins...)
+
+					code, output, err := c.Exec(ctx, cmd, exec.Multiplexed())
+					if err != nil {
+						return fmt.Errorf("failed to enable plugins %v: %w", plugins, err)
+					}
+
+					if code != 0 {
+						out, _ := io.ReadAll(output)
+						return fmt.Errorf("failed to enable plugins %v, exit code %d: %s", plugins, code, string(out))
+					}
+
+					return nil
+				},
+			},
+		})
+	}
+}
+
 func renderRabbitMQConfig(opts options) ([]byte, error) {
 	rabbitCustomConfigTpl, err := template.New("rabbitmq-testcontainers.conf").Parse(customConfigTpl)
 	if err != nil {
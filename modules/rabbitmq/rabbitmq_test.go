@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -42,6 +43,45 @@ func TestRunContainer_connectUsingAmqp(t *testing.T) {
 	}
 }
 
+func TestRunContainer_connectUsingAmqps(t *testing.T) {
+	ctx := context.Background()
+
+	sslSettings := rabbitmq.SSLSettings{
+		CACertFile:        filepath.Join("testdata", "certs", "server_ca.pem"),
+		CertFile:          filepath.Join("testdata", "certs", "server_cert.pem"),
+		KeyFile:           filepath.Join("testdata", "certs", "server_key.pem"),
+		VerificationMode:  rabbitmq.SSLVerificationModePeer,
+		FailIfNoCert:      false,
+		VerificationDepth: 1,
+	}
+
+	rabbitmqContainer, err := rabbitmq.RunContainer(ctx, rabbitmq.WithSSL(sslSettings))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if err := rabbitmqContainer.Terminate(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	amqpsURL, err := rabbitmqContainer.AmqpsURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amqpsPort, err := rabbitmqContainer.MappedPort(ctx, rabbitmq.DefaultAMQPSPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the AMQPS URL must point to the AMQPS port, and not to the plain AMQP one
+	if !strings.HasPrefix(amqpsURL, "amqps://") || !strings.HasSuffix(amqpsURL, ":"+amqpsPort.Port()) {
+		t.Fatalf("expected the AMQPS URL to use the AMQPS port %s, got %s", amqpsPort.Port(), amqpsURL)
+	}
+}
+
 func TestRunContainer_withAllSettings(t *testing.T) {
 	ctx := context.Background()
 
@@ -191,6 +231,51 @@ func TestRunContainer_withAllSettings(t *testing.T) {
 	}
 }
 
+func TestRunContainer_withDefinitionsAndPlugins(t *testing.T) {
+	ctx := context.Background()
+
+	rabbitmqContainer, err := rabbitmq.RunContainer(ctx,
+		// withDefinitions {
+		rabbitmq.WithDefinitions(filepath.Join("testdata", "definitions.json")),
+		// }
+		// withPluginsEnabled {
+		rabbitmq.WithPluginsEnabled("rabbitmq_mqtt", "rabbitmq_shovel"),
+		// }
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if err := rabbitmqContainer.Terminate(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if !assertEntity(t, rabbitmqContainer, "queues", "orders.created") {
+		t.Fatal("queue from definitions not found")
+	}
+	if !assertEntity(t, rabbitmqContainer, "exchanges", "orders") {
+		t.Fatal("exchange from definitions not found")
+	}
+	if !assertEntity(t, rabbitmqContainer, "bindings", "orders.created") {
+		t.Fatal("binding from definitions not found")
+	}
+	if !assertPluginIsEnabled(t, rabbitmqContainer, "rabbitmq_mqtt", "rabbitmq_shovel") {
+		t.Fatal("plugins not enabled")
+	}
+}
+
+func TestRunContainer_withUnknownPlugin(t *testing.T) {
+	ctx := context.Background()
+
+	rabbitmqContainer, err := rabbitmq.RunContainer(ctx, rabbitmq.WithPluginsEnabled("rabbitmq_unknown_plugin"))
+	if err == nil {
+		_ = rabbitmqContainer.Terminate(ctx)
+		t.Fatal("expected an error enabling an unknown plugin")
+	}
+}
+
 func assertEntity(t *testing.T, container testcontainers.Container, listCommand string, entities ...string) bool {
 	t.Helper()
 
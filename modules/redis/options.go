@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// tlsEnabled enables TLS on the Redis port, using certificates generated when the container starts.
+	tlsEnabled bool
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Redis container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithTLS enables TLS on the Redis port, using a server certificate signed by a CA that is
+// generated when the container starts. The plain text port is disabled, so clients must use
+// the TLS configuration returned by the TLSConfig method of the container. Client certificates
+// are not required.
+func WithTLS() Option {
+	return func(o *options) {
+		o.tlsEnabled = true
+	}
+}
@@ -2,7 +2,10 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"strings"
 
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -14,6 +17,12 @@ const defaultImage = "docker.io/redis:7"
 // redisServerProcess is the name of the redis server process
 const redisServerProcess = "redis-server"
 
+const (
+	tlsCACertFile = "/tls/ca.crt"
+	tlsCertFile   = "/tls/server.crt"
+	tlsKeyFile    = "/tls/server.key"
+)
+
 type LogLevel string
 
 const (
@@ -27,10 +36,14 @@ const (
 	LogLevelWarning LogLevel = "warning"
 )
 
+// RedisContainer represents the Redis container type used in the module
 type RedisContainer struct {
 	testcontainers.Container
+	tlsConfig *tls.Config
 }
 
+// ConnectionString returns the connection string to connect to the Redis container, using the
+// host and the mapped port of the default 6379 port. If TLS is enabled, the rediss scheme is used.
 func (c *RedisContainer) ConnectionString(ctx context.Context) (string, error) {
 	mappedPort, err := c.MappedPort(ctx, "6379/tcp")
 	if err != nil {
@@ -42,10 +55,21 @@ func (c *RedisContainer) ConnectionString(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	uri := fmt.Sprintf("redis://%s:%s", hostIP, mappedPort.Port())
+	schema := "redis"
+	if c.tlsConfig != nil {
+		schema = "rediss"
+	}
+
+	uri := fmt.Sprintf("%s://%s:%s", schema, hostIP, mappedPort.Port())
 	return uri, nil
 }
 
+// TLSConfig returns the client TLS configuration to connect to the Redis container, which trusts
+// the CA that signed the server certificate. It returns nil if TLS is not enabled.
+func (c *RedisContainer) TLSConfig() *tls.Config {
+	return c.tlsConfig
+}
+
 // RunContainer creates an instance of the Redis container type
 func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*RedisContainer, error) {
 	req := testcontainers.ContainerRequest{
@@ -59,16 +83,108 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		Started:          true,
 	}
 
+	settings := defaultOptions()
 	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
 		opt.Customize(&genericContainerReq)
 	}
 
+	var tlsConfig *tls.Config
+	if settings.tlsEnabled {
+		var err error
+		tlsConfig, err = configureTLS(&genericContainerReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isRedisStack(genericContainerReq.Image) {
+		moveArgsToRedisStackEnv(&genericContainerReq)
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
 	if err != nil {
 		return nil, err
 	}
 
-	return &RedisContainer{Container: container}, nil
+	return &RedisContainer{Container: container, tlsConfig: tlsConfig}, nil
+}
+
+// configureTLS generates the certificates, copies them into the container once it's created,
+// and enables TLS on the Redis port, disabling the plain text one.
+func configureTLS(req *testcontainers.GenericContainerRequest) (*tls.Config, error) {
+	caCert, cert, key, err := generateCertificates("localhost", "127.0.0.1", "::1")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificates: %w", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to append CA certificate to the pool")
+	}
+
+	req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostCreates: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				// the files must be readable by the redis user, which runs the server process
+				for path, content := range map[string][]byte{tlsCACertFile: caCert, tlsCertFile: cert, tlsKeyFile: key} {
+					if err := c.CopyToContainer(ctx, content, path, 0o644); err != nil {
+						return fmt.Errorf("failed to copy %s: %w", path, err)
+					}
+				}
+				return nil
+			},
+		},
+	})
+
+	processRedisServerArgs(req, []string{
+		"--port", "0",
+		"--tls-port", "6379",
+		"--tls-cert-file", tlsCertFile,
+		"--tls-key-file", tlsKeyFile,
+		"--tls-ca-cert-file", tlsCACertFile,
+		"--tls-auth-clients", "no",
+	})
+
+	return &tls.Config{
+		RootCAs:    caCertPool,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+// isRedisStack returns true if the image is one of the Redis Stack images, which load
+// the Redis modules (RedisJSON, RediSearch, etc.) from their own entrypoint.
+func isRedisStack(image string) bool {
+	return strings.Contains(image, "redis-stack")
+}
+
+// moveArgsToRedisStackEnv moves the arguments of the redis server process to the REDIS_ARGS
+// environment variable, so that the entrypoint of the Redis Stack images is not overridden
+// and the Redis modules are still loaded. The config file, if any, is included instead of being
+// passed as the first argument, as the entrypoint already passes its own config file.
+func moveArgsToRedisStackEnv(req *testcontainers.GenericContainerRequest) {
+	if len(req.Cmd) == 0 || req.Cmd[0] != redisServerProcess {
+		return
+	}
+
+	args := req.Cmd[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "--") {
+		args = append([]string{"--include", args[0]}, args[1:]...)
+	}
+
+	if req.Env == nil {
+		req.Env = map[string]string{}
+	}
+
+	redisArgs := strings.Join(args, " ")
+	if existing := req.Env["REDIS_ARGS"]; existing != "" {
+		redisArgs = existing + " " + redisArgs
+	}
+
+	req.Env["REDIS_ARGS"] = redisArgs
+	req.Cmd = nil
 }
 
 // WithConfigFile sets the config file to be used for the redis container, and sets the command to run the redis server
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -86,6 +87,70 @@ func TestRedisWithImage(t *testing.T) {
 	}
 }
 
+func TestRedisWithTLS(t *testing.T) {
+	ctx := context.Background()
+
+	// withTLS {
+	redisContainer, err := RunContainer(ctx, WithTLS())
+	// }
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := redisContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	uri, err := redisContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(uri, "rediss://"))
+
+	// tlsConfig {
+	options, err := redis.ParseURL(uri)
+	require.NoError(t, err)
+
+	options.TLSConfig = redisContainer.TLSConfig()
+
+	client := redis.NewClient(options)
+	// }
+	defer client.Close()
+
+	pong, err := client.Ping(ctx).Result()
+	require.NoError(t, err)
+	require.Equal(t, "PONG", pong)
+}
+
+func TestRedisStackModules(t *testing.T) {
+	ctx := context.Background()
+
+	redisContainer, err := RunContainer(ctx,
+		testcontainers.WithImage("docker.io/redis/redis-stack-server:latest"),
+		WithLogLevel(LogLevelVerbose),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := redisContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	uri, err := redisContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	options, err := redis.ParseURL(uri)
+	require.NoError(t, err)
+
+	client := redis.NewClient(options)
+	defer client.Close()
+
+	// the RedisJSON module is loaded by the entrypoint of the Redis Stack images
+	err = client.Do(ctx, "JSON.SET", "user:1", "$", `{"name":"testcontainers"}`).Err()
+	require.NoError(t, err)
+
+	name, err := client.Do(ctx, "JSON.GET", "user:1", "$.name").Text()
+	require.NoError(t, err)
+	require.Equal(t, `["testcontainers"]`, name)
+}
+
 func TestRedisWithLogLevel(t *testing.T) {
 	ctx := context.Background()
 
@@ -292,3 +357,55 @@ func TestWithSnapshotting(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveArgsToRedisStackEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		cmds         []string
+		env          map[string]string
+		expectedCmds []string
+		expectedArgs string
+	}{
+		{
+			name:         "no existing command",
+			cmds:         []string{},
+			expectedCmds: []string{},
+			expectedArgs: "",
+		},
+		{
+			name:         "redis-server with arguments",
+			cmds:         []string{redisServerProcess, "--loglevel", "debug"},
+			expectedCmds: nil,
+			expectedArgs: "--loglevel debug",
+		},
+		{
+			name:         "redis-server with config file",
+			cmds:         []string{redisServerProcess, "/usr/local/redis.conf", "--loglevel", "debug"},
+			expectedCmds: nil,
+			expectedArgs: "--include /usr/local/redis.conf --loglevel debug",
+		},
+		{
+			name:         "existing REDIS_ARGS",
+			cmds:         []string{redisServerProcess, "--loglevel", "debug"},
+			env:          map[string]string{"REDIS_ARGS": "--requirepass secret"},
+			expectedCmds: nil,
+			expectedArgs: "--requirepass secret --loglevel debug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &testcontainers.GenericContainerRequest{
+				ContainerRequest: testcontainers.ContainerRequest{
+					Cmd: tt.cmds,
+					Env: tt.env,
+				},
+			}
+
+			moveArgsToRedisStackEnv(req)
+
+			require.Equal(t, tt.expectedCmds, req.Cmd)
+			require.Equal(t, tt.expectedArgs, req.Env["REDIS_ARGS"])
+		})
+	}
+}
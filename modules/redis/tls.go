@@ -0,0 +1,26 @@
+package redis
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go/internal/tlscert"
+)
+
+// generateCertificates creates a self-signed CA, and a server certificate and key
+// signed by that CA, valid for the given hosts. All of them are returned PEM encoded.
+func generateCertificates(hosts ...string) (caCert []byte, cert []byte, key []byte, err error) {
+	ca, err := tlscert.NewCA("Testcontainers Redis CA")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the server certificate is also used by the replication and cluster bus connections,
+	// where Redis acts as a client.
+	cert, key, err = ca.Sign("redis", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, hosts...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create server certificate: %w", err)
+	}
+
+	return ca.Cert, cert, key, nil
+}
@@ -35,11 +35,23 @@ type options struct {
 	// EnableTLS is a flag to enable TLS.
 	EnableTLS bool
 
+	// generateTLS is a flag to generate a CA, and a certificate and key signed by it,
+	// when the container starts.
+	generateTLS bool
+
 	cert, key []byte
 
 	// Listeners is a list of custom listeners that can be provided to access the
 	// containers form within docker networks
 	Listeners []listener
+
+	// Topics is a list of topics that shall be created once Redpanda is ready
+	// to serve requests.
+	Topics []topic
+
+	// ExtraBootstrapConfig is a map of cluster properties (key) and their values,
+	// which will be added to the bootstrap config file.
+	ExtraBootstrapConfig map[string]any
 }
 
 func defaultOptions() options {
@@ -52,6 +64,8 @@ func defaultOptions() options {
 		AutoCreateTopics:                   false,
 		EnableTLS:                          false,
 		Listeners:                          make([]listener, 0),
+		Topics:                             make([]topic, 0),
+		ExtraBootstrapConfig:               make(map[string]any, 0),
 	}
 }
 
@@ -66,6 +80,11 @@ func (o Option) Customize(*testcontainers.GenericContainerRequest) {
 	// NOOP to satisfy interface.
 }
 
+// WithNewServiceAccount includes a new user with username (key) and password (value)
+// that shall be created, so that you can use these to authenticate against
+// Redpanda (either for the Kafka API or Schema Registry HTTP access).
+// The users are created using the Admin API once the broker is ready.
+// Please use the SCRAM-SHA-256 mechanism when authenticating on the Kafka API.
 func WithNewServiceAccount(username, password string) Option {
 	return func(o *options) {
 		o.ServiceAccounts[username] = password
@@ -98,13 +117,28 @@ func WithEnableKafkaAuthorization() Option {
 }
 
 // WithEnableSchemaRegistryHTTPBasicAuth enables HTTP basic authentication for
-// Schema Registry.
+// Schema Registry. The users created with WithNewServiceAccount can be used
+// as credentials.
 func WithEnableSchemaRegistryHTTPBasicAuth() Option {
 	return func(o *options) {
 		o.SchemaRegistryAuthenticationMethod = "http_basic"
 	}
 }
 
+// WithTopic adds a topic with the given number of partitions and replication factor,
+// which will be created right after Redpanda is ready to serve requests.
+// If Kafka authorization is enabled, one of the superusers must have been
+// created with WithNewServiceAccount, as its credentials are used to create the topic.
+func WithTopic(name string, partitions int, replicationFactor int) Option {
+	return func(o *options) {
+		o.Topics = append(o.Topics, topic{
+			Name:              name,
+			Partitions:        partitions,
+			ReplicationFactor: replicationFactor,
+		})
+	}
+}
+
 // WithAutoCreateTopics enables topic auto creation.
 func WithAutoCreateTopics() Option {
 	return func(o *options) {
@@ -112,18 +146,53 @@ func WithAutoCreateTopics() Option {
 	}
 }
 
+// WithBootstrapConfig adds an arbitrary cluster property to the bootstrap config file,
+// which is applied the very first time the cluster starts, e.g. to enable transactions
+// or to change the retention settings. The value is rendered as YAML, so any
+// scalar, slice or map value is allowed. The properties set by the dedicated options,
+// e.g. superusers with WithSuperusers, are rejected.
+// Reference: https://docs.redpanda.com/docs/reference/cluster-properties/
+func WithBootstrapConfig(key string, value any) Option {
+	return func(o *options) {
+		o.ExtraBootstrapConfig[key] = value
+	}
+}
+
+// WithTLS enables TLS encryption for the external Kafka API, the Admin API and the Schema Registry
+// listeners, using the given PEM encoded certificate and key. The custom listeners added
+// with the WithListener option are not encrypted.
 func WithTLS(cert, key []byte) Option {
 	return func(o *options) {
 		o.EnableTLS = true
+		o.generateTLS = false
 		o.cert = cert
 		o.key = key
 	}
 }
 
+// WithAutoGeneratedTLS enables TLS encryption for the external Kafka API, the Admin API and the Schema Registry
+// listeners, using a certificate and key signed by a CA that is generated when the container starts.
+// The certificate is valid for localhost, and the custom listeners added with the WithListener option
+// are not encrypted.
+// Use the CACertificate method of the container to retrieve the CA, so that clients can trust it.
+func WithAutoGeneratedTLS() Option {
+	return func(o *options) {
+		o.EnableTLS = true
+		o.generateTLS = true
+		o.cert = nil
+		o.key = nil
+	}
+}
+
 // WithListener adds a custom listener to the Redpanda containers. Listener
 // will be aliases to all networks, so they can be accessed from within docker
 // networks. At leas one network must be attached to the container, if not an
 // error will be thrown when starting the container.
+// The listener is advertised with the given host and port, so other containers
+// in the same network (e.g. Kafka Connect) can use it as bootstrap server, while
+// clients running on the host keep using the mapped port returned by KafkaSeedBroker.
+// The listener uses the same authentication method as the external Kafka listener,
+// regardless of the order in which the options are passed.
 func WithListener(lis string) Option {
 	host, port, err := net.SplitHostPort(lis)
 	if err != nil {
@@ -137,9 +206,8 @@ func WithListener(lis string) Option {
 
 	return func(o *options) {
 		o.Listeners = append(o.Listeners, listener{
-			Address:              host,
-			Port:                 portInt,
-			AuthenticationMethod: o.KafkaAuthenticationMethod,
+			Address: host,
+			Port:    portInt,
 		})
 	}
 }
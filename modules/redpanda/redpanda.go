@@ -4,16 +4,21 @@ import (
 	"bytes"
 	"context"
 	_ "embed"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"text/template"
 	"time"
 
 	"github.com/docker/go-connections/nat"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -45,6 +50,7 @@ const (
 type Container struct {
 	testcontainers.Container
 	urlScheme string
+	caCert    []byte
 }
 
 // RunContainer creates an instance of the Redpanda container type.
@@ -89,6 +95,16 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		opt.Customize(&req)
 	}
 
+	// The credentials used to create the topics only depend on the options,
+	// so they are validated before the container is started.
+	var topicsUser, topicsPassword string
+	if len(settings.Topics) > 0 && settings.KafkaEnableAuthorization {
+		topicsUser, topicsPassword, err = topicsSuperuser(settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 3. Create temporary entrypoint file. We need a custom entrypoint that waits
 	// until the actual Redpanda node config is mounted. Once the redpanda config is
 	// mounted we will call the original entrypoint with the same parameters.
@@ -102,7 +118,7 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 
 	// 4. Register extra kafka listeners if provided, network aliases will be
 	// set
-	if err := registerListeners(ctx, settings, req); err != nil {
+	if err := registerListeners(ctx, &settings, req); err != nil {
 		return nil, fmt.Errorf("failed to register listeners: %w", err)
 	}
 
@@ -131,6 +147,14 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 	)
 
 	// 5. Create certificate and key for TLS connections.
+	var caCert []byte
+	if settings.generateTLS {
+		caCert, settings.cert, settings.key, err = generateCertificates("localhost", "127.0.0.1", "::1")
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate certificates: %w", err)
+		}
+	}
+
 	if settings.EnableTLS {
 		certPath := filepath.Join(tmpDir, certFile)
 		if err := os.WriteFile(certPath, settings.cert, 0o600); err != nil {
@@ -193,6 +217,11 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 		return nil, fmt.Errorf("failed to wait for Redpanda readiness: %w", err)
 	}
 
+	scheme := "http"
+	if settings.EnableTLS {
+		scheme += "s"
+	}
+
 	// 9. Create Redpanda Service Accounts if configured to do so.
 	if len(settings.ServiceAccounts) > 0 {
 		adminAPIPort, err := container.MappedPort(ctx, nat.Port(defaultAdminAPIPort))
@@ -200,22 +229,38 @@ func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomize
 			return nil, fmt.Errorf("failed to get mapped Admin API port: %w", err)
 		}
 
-		adminAPIUrl := fmt.Sprintf("http://%v:%d", hostIP, adminAPIPort.Int())
+		adminAPIUrl := fmt.Sprintf("%s://%v:%d", scheme, hostIP, adminAPIPort.Int())
 		adminCl := NewAdminAPIClient(adminAPIUrl)
+		if settings.EnableTLS {
+			adminCl = adminCl.WithHTTPClient(tlsHTTPClient(caCert, settings.cert))
+		}
 
 		for username, password := range settings.ServiceAccounts {
 			if err := adminCl.CreateUser(ctx, username, password); err != nil {
+				// the container can't be used by the caller, so it's terminated
+				_ = container.Terminate(ctx)
 				return nil, fmt.Errorf("failed to create service account with username %q: %w", username, err)
 			}
 		}
 	}
 
-	scheme := "http"
-	if settings.EnableTLS {
-		scheme += "s"
+	// 10. Create the topics if configured to do so.
+	if len(settings.Topics) > 0 {
+		if err := createTopics(ctx, container, settings, topicsUser, topicsPassword); err != nil {
+			// the container can't be used by the caller, so it's terminated
+			_ = container.Terminate(ctx)
+			return nil, err
+		}
 	}
 
-	return &Container{Container: container, urlScheme: scheme}, nil
+	return &Container{Container: container, urlScheme: scheme, caCert: caCert}, nil
+}
+
+// CACertificate returns the PEM encoded CA certificate that signed the certificate
+// used by the TLS listeners, when it was generated with the WithAutoGeneratedTLS option.
+// It returns nil otherwise.
+func (c *Container) CACertificate() []byte {
+	return c.caCert
 }
 
 // KafkaSeedBroker returns the seed broker that should be used for connecting
@@ -226,25 +271,52 @@ func (c *Container) KafkaSeedBroker(ctx context.Context) (string, error) {
 }
 
 // AdminAPIAddress returns the address to the Redpanda Admin API. This
-// is an HTTP-based API and thus the returned format will be: http://host:port.
+// is an HTTP-based API and thus the returned format will be: http://host:port,
+// or https://host:port if TLS is enabled.
 func (c *Container) AdminAPIAddress(ctx context.Context) (string, error) {
 	return c.PortEndpoint(ctx, nat.Port(defaultAdminAPIPort), c.urlScheme)
 }
 
 // SchemaRegistryAddress returns the address to the schema registry API. This
-// is an HTTP-based API and thus the returned format will be: http://host:port.
+// is an HTTP-based API and thus the returned format will be: http://host:port,
+// or https://host:port if TLS is enabled. If HTTP basic authentication is enabled,
+// use the credentials of any of the service accounts to access it.
 func (c *Container) SchemaRegistryAddress(ctx context.Context) (string, error) {
 	return c.PortEndpoint(ctx, nat.Port(defaultSchemaRegistryPort), c.urlScheme)
 }
 
+// reservedBootstrapConfig are the cluster properties rendered from the dedicated options,
+// which can't be set with the WithBootstrapConfig option.
+var reservedBootstrapConfig = map[string]string{
+	"superusers":                 "WithSuperusers",
+	"kafka_enable_authorization": "WithEnableKafkaAuthorization",
+	"auto_create_topics_enabled": "WithAutoCreateTopics",
+}
+
 // renderBootstrapConfig renders the config template for the .bootstrap.yaml config,
 // which configures Redpanda's cluster properties.
 // Reference: https://docs.redpanda.com/docs/reference/cluster-properties/
 func renderBootstrapConfig(settings options) ([]byte, error) {
+	// JSON is a subset of YAML, so the values can be safely rendered as JSON
+	extraConfig := make(map[string]string, len(settings.ExtraBootstrapConfig))
+	for k, v := range settings.ExtraBootstrapConfig {
+		// the properties set by the dedicated options would be rendered twice
+		if option, ok := reservedBootstrapConfig[k]; ok {
+			return nil, fmt.Errorf("the bootstrap config property %q must be set with the %s option", k, option)
+		}
+
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal bootstrap config property %q: %w", k, err)
+		}
+		extraConfig[k] = string(value)
+	}
+
 	bootstrapTplParams := redpandaBootstrapConfigTplParams{
 		Superusers:                  settings.Superusers,
 		KafkaAPIEnableAuthorization: settings.KafkaEnableAuthorization,
 		AutoCreateTopics:            settings.AutoCreateTopics,
+		ExtraBootstrapConfig:        extraConfig,
 	}
 
 	tpl, err := template.New("bootstrap.yaml").Parse(bootstrapConfigTpl)
@@ -260,9 +332,51 @@ func renderBootstrapConfig(settings options) ([]byte, error) {
 	return bootstrapConfig.Bytes(), nil
 }
 
+// topicsSuperuser returns the credentials of the first superuser with a service account,
+// which are required to create the topics when Kafka authorization is enabled.
+func topicsSuperuser(settings options) (string, string, error) {
+	for _, superuser := range settings.Superusers {
+		if pwd, ok := settings.ServiceAccounts[superuser]; ok {
+			return superuser, pwd, nil
+		}
+	}
+
+	return "", "", errors.New("a superuser with a service account is required to create topics when Kafka authorization is enabled")
+}
+
+// createTopics creates the topics defined in the settings using rpk inside the container,
+// connecting to the internal Kafka listener. If Kafka authorization is enabled, the
+// credentials of the first superuser with a service account are used.
+func createTopics(ctx context.Context, c testcontainers.Container, settings options, user string, password string) error {
+	baseCmd := []string{"rpk", "topic", "create", "-X", "brokers=localhost:9093"}
+
+	if settings.KafkaEnableAuthorization {
+		baseCmd = append(baseCmd, "-X", "user="+user, "-X", "pass="+password, "-X", "sasl.mechanism=SCRAM-SHA-256")
+	}
+
+	for _, t := range settings.Topics {
+		cmd := make([]string, 0, len(baseCmd)+5)
+		cmd = append(cmd, baseCmd...)
+		cmd = append(cmd, t.Name, "-p", strconv.Itoa(t.Partitions), "-r", strconv.Itoa(t.ReplicationFactor))
+
+		code, output, err := c.Exec(ctx, cmd, exec.Multiplexed())
+		if err != nil {
+			return fmt.Errorf("failed to create topic %q: %w", t.Name, err)
+		}
+
+		if code != 0 {
+			out, _ := io.ReadAll(output)
+			return fmt.Errorf("failed to create topic %q. Exit code: %d. Output: %s", t.Name, code, out)
+		}
+	}
+
+	return nil
+}
+
 // registerListeners validates that the provided listeners are valid and set network aliases for the provided addresses.
+// Listeners without an explicit authentication method inherit the one of the external Kafka listener.
 // The container must be attached to at least one network.
-func registerListeners(ctx context.Context, settings options, req testcontainers.GenericContainerRequest) error {
+func registerListeners(ctx context.Context, settings *options, req testcontainers.GenericContainerRequest) error {
 	if len(settings.Listeners) == 0 {
 		return nil
 	}
@@ -271,11 +385,15 @@ func registerListeners(ctx context.Context, settings options, req testcontainers
 		return fmt.Errorf("container must be attached to at least one network")
 	}
 
-	for _, listener := range settings.Listeners {
+	for i, listener := range settings.Listeners {
 		if listener.Port < 0 || listener.Port > math.MaxUint16 {
 			return fmt.Errorf("invalid port on listener %s:%d (must be between 0 and 65535)", listener.Address, listener.Port)
 		}
 
+		if listener.AuthenticationMethod == "" {
+			settings.Listeners[i].AuthenticationMethod = settings.KafkaAuthenticationMethod
+		}
+
 		for _, network := range req.Networks {
 			req.NetworkAliases[network] = append(req.NetworkAliases[network], listener.Address)
 		}
@@ -318,6 +436,7 @@ type redpandaBootstrapConfigTplParams struct {
 	Superusers                  []string
 	KafkaAPIEnableAuthorization bool
 	AutoCreateTopics            bool
+	ExtraBootstrapConfig        map[string]string
 }
 
 type redpandaConfigTplParams struct {
@@ -344,3 +463,9 @@ type listener struct {
 	Port                 int
 	AuthenticationMethod string
 }
+
+type topic struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+}
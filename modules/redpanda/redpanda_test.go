@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -213,6 +214,101 @@ func TestRedpandaProduceWithAutoCreateTopics(t *testing.T) {
 	require.NoError(t, results.FirstErr())
 }
 
+func TestRedpandaWithTopics(t *testing.T) {
+	ctx := context.Background()
+
+	// withTopic {
+	container, err := RunContainer(ctx,
+		WithTopic("orders", 3, 1),
+		WithTopic("payments", 1, 1),
+	)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	brokers, err := container.KafkaSeedBroker(ctx)
+	require.NoError(t, err)
+
+	kafkaCl, err := kgo.NewClient(kgo.SeedBrokers(brokers))
+	require.NoError(t, err)
+	defer kafkaCl.Close()
+
+	kafkaAdmCl := kadm.NewClient(kafkaCl)
+	topics, err := kafkaAdmCl.ListTopics(ctx)
+	require.NoError(t, err)
+
+	require.True(t, topics.Has("orders"))
+	assert.Len(t, topics["orders"].Partitions, 3)
+	require.True(t, topics.Has("payments"))
+	assert.Len(t, topics["payments"].Partitions, 1)
+
+	results := kafkaCl.ProduceSync(ctx, &kgo.Record{Topic: "orders", Value: []byte("test message")})
+	require.NoError(t, results.FirstErr())
+}
+
+func TestRedpandaWithTopics_authorizationWithoutSuperuser(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := RunContainer(ctx,
+		WithEnableSASL(),
+		WithEnableKafkaAuthorization(),
+		WithNewServiceAccount("no-superuser", "test"),
+		WithTopic("orders", 1, 1),
+	)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "a superuser with a service account is required to create topics")
+}
+
+func TestRedpandaWithBootstrapConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// withBootstrapConfig {
+	container, err := RunContainer(ctx,
+		WithBootstrapConfig("log_retention_ms", 3600000),
+		WithBootstrapConfig("kafka_nodelete_topics", []string{"protected"}),
+	)
+	// }
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	adminAPIURL, err := container.AdminAPIAddress(ctx)
+	require.NoError(t, err)
+
+	httpCl := &http.Client{Timeout: 5 * time.Second}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/cluster_config", adminAPIURL), nil)
+	require.NoError(t, err)
+	resp, err := httpCl.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var clusterConfig map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&clusterConfig))
+
+	assert.EqualValues(t, 3600000, clusterConfig["log_retention_ms"])
+	assert.Equal(t, []any{"protected"}, clusterConfig["kafka_nodelete_topics"])
+}
+
+func TestRedpandaWithBootstrapConfig_reservedProperty(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := RunContainer(ctx,
+		WithAutoCreateTopics(),
+		WithBootstrapConfig("auto_create_topics_enabled", false),
+	)
+	require.ErrorContains(t, err, `the bootstrap config property "auto_create_topics_enabled" must be set with the WithAutoCreateTopics option`)
+}
+
 func TestRedpandaWithTLS(t *testing.T) {
 	cert, err := tls.X509KeyPair(localhostCert, localhostKey)
 	require.NoError(t, err, "failed to load key pair")
@@ -259,7 +355,7 @@ func TestRedpandaWithTLS(t *testing.T) {
 	// Test Schema Registry API
 	schemaRegistryURL, err := container.SchemaRegistryAddress(ctx)
 	require.NoError(t, err)
-	require.True(t, strings.HasPrefix(adminAPIURL, "https://"), "SchemaRegistryAddress should return https url")
+	require.True(t, strings.HasPrefix(schemaRegistryURL, "https://"), "SchemaRegistryAddress should return https url")
 	req, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/subjects", schemaRegistryURL), nil)
 	require.NoError(t, err)
 	resp, err = httpCl.Do(req)
@@ -282,6 +378,110 @@ func TestRedpandaWithTLS(t *testing.T) {
 	require.Error(t, results.FirstErr(), kerr.UnknownTopicOrPartition)
 }
 
+func TestRedpandaWithAutoGeneratedTLS(t *testing.T) {
+	ctx := context.Background()
+
+	// autoGeneratedTLS {
+	container, err := RunContainer(ctx, WithAutoGeneratedTLS())
+	require.NoError(t, err)
+
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(container.CACertificate())
+
+	tlsConfig := &tls.Config{
+		RootCAs: caCertPool,
+	}
+	// }
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	httpCl := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSHandshakeTimeout: 10 * time.Second,
+			TLSClientConfig:     tlsConfig,
+		},
+	}
+
+	// Test Admin API
+	adminAPIURL, err := container.AdminAPIAddress(ctx)
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/cluster/health_overview", adminAPIURL), nil)
+	require.NoError(t, err)
+	resp, err := httpCl.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// Test Schema Registry API
+	schemaRegistryURL, err := container.SchemaRegistryAddress(ctx)
+	require.NoError(t, err)
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/subjects", schemaRegistryURL), nil)
+	require.NoError(t, err)
+	resp, err = httpCl.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	brokers, err := container.KafkaSeedBroker(ctx)
+	require.NoError(t, err)
+
+	kafkaCl, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers),
+		kgo.DialTLSConfig(tlsConfig),
+	)
+	require.NoError(t, err)
+	defer kafkaCl.Close()
+
+	kafkaAdmCl := kadm.NewClient(kafkaCl)
+	metadata, err := kafkaAdmCl.Metadata(ctx)
+	require.NoError(t, err)
+	assert.Len(t, metadata.Brokers, 1)
+}
+
+func TestRedpandaWithAutoGeneratedTLSAndServiceAccount(t *testing.T) {
+	ctx := context.Background()
+
+	// the service account is created through the Admin API, which is served over TLS
+	container, err := RunContainer(ctx,
+		WithAutoGeneratedTLS(),
+		WithEnableSASL(),
+		WithNewServiceAccount("superuser-1", "test"),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(container.CACertificate())
+
+	brokers, err := container.KafkaSeedBroker(ctx)
+	require.NoError(t, err)
+
+	kafkaCl, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers),
+		kgo.DialTLSConfig(&tls.Config{RootCAs: caCertPool}),
+		kgo.SASL(scram.Auth{
+			User: "superuser-1",
+			Pass: "test",
+		}.AsSha256Mechanism()),
+	)
+	require.NoError(t, err)
+	defer kafkaCl.Close()
+
+	kafkaAdmCl := kadm.NewClient(kafkaCl)
+	metadata, err := kafkaAdmCl.Metadata(ctx)
+	require.NoError(t, err)
+	assert.Len(t, metadata.Brokers, 1)
+}
 func TestRedpandaListener_Simple(t *testing.T) {
 	ctx := context.Background()
 
@@ -395,6 +595,29 @@ func TestRedpandaListener_NoNetwork(t *testing.T) {
 	require.Contains(t, err.Error(), "container must be attached to at least one network")
 }
 
+func TestRedpandaListener_InheritsAuthenticationMethod(t *testing.T) {
+	// the listener is registered before SASL is enabled, so its authentication
+	// method must be resolved once all the options have been applied.
+	settings := defaultOptions()
+	for _, opt := range []Option{WithListener("redpanda:29092"), WithEnableSASL()} {
+		opt(&settings)
+	}
+
+	req := testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Networks:       []string{"redpanda-network"},
+			NetworkAliases: map[string][]string{},
+		},
+	}
+
+	err := registerListeners(context.Background(), &settings, req)
+	require.NoError(t, err)
+
+	require.Len(t, settings.Listeners, 1)
+	assert.Equal(t, "sasl", settings.Listeners[0].AuthenticationMethod)
+	assert.Equal(t, []string{"redpanda"}, req.NetworkAliases["redpanda-network"])
+}
+
 // localhostCert is a PEM-encoded TLS cert with SAN IPs
 // generated from src/crypto/tls:
 // go run generate_cert.go  --rsa-bits 2048 --host 127.0.0.1,::1,localhost --ca --start-date "Jan 1 00:00:00 1970" --duration=1000000h
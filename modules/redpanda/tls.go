@@ -0,0 +1,45 @@
+package redpanda
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/internal/tlscert"
+)
+
+// generateCertificates creates a self-signed CA, and a server certificate and key
+// signed by that CA, valid for the given hosts. All of them are returned PEM encoded.
+func generateCertificates(hosts ...string) (caCert []byte, cert []byte, key []byte, err error) {
+	ca, err := tlscert.NewCA("Testcontainers Redpanda CA")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	cert, key, err = ca.Sign("redpanda", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, hosts...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create server certificate: %w", err)
+	}
+
+	return ca.Cert, cert, key, nil
+}
+
+// tlsHTTPClient returns an HTTP client trusting the CA that signed the certificate of the TLS
+// listeners, and the certificate itself, as the CA is unknown when it's provided with the WithTLS option.
+func tlsHTTPClient(caCert []byte, cert []byte) *http.Client {
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM(caCert)
+	certPool.AppendCertsFromPEM(cert)
+
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSHandshakeTimeout: 10 * time.Second,
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+}
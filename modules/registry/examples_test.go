@@ -0,0 +1,37 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/registry"
+)
+
+func ExampleRunContainer() {
+	// runRegistryContainer {
+	ctx := context.Background()
+
+	registryContainer, err := registry.RunContainer(ctx, testcontainers.WithImage("registry:2.8.3"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := registryContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := registryContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Users is a map of usernames (key) and passwords (value) added to the htpasswd file.
+	Users map[string]string
+	// DataPath is the path to a directory in the host, with the storage of a registry.
+	DataPath string
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate and key in the host,
+	// used to serve the registry over HTTPS.
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+func defaultOptions() options {
+	return options{
+		Users: make(map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Registry container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUser adds a user to the htpasswd file of the registry, enabling the basic authentication.
+// It can be called multiple times. The credentials of the first user, in alphabetical order,
+// are used by the PushImage method of the container.
+func WithUser(username string, password string) Option {
+	return func(o *options) {
+		o.Users[username] = password
+	}
+}
+
+// WithData sets the path to a directory in the host, with the storage of a registry,
+// i.e. containing the "docker/registry/v2" tree, which is copied into the container
+// to pre-seed the registry with images.
+func WithData(dataPath string) Option {
+	return func(o *options) {
+		o.DataPath = dataPath
+	}
+}
+
+// WithTLS serves the registry over HTTPS, using the PEM encoded certificate and key
+// in the given paths of the host.
+func WithTLS(certFile string, keyFile string) Option {
+	return func(o *options) {
+		o.TLSCertFile = certFile
+		o.TLSKeyFile = keyFile
+	}
+}
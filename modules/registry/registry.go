@@ -0,0 +1,260 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	registryPort = "5000/tcp"
+
+	dataPath     = "/data"
+	htpasswdPath = "/auth/htpasswd"
+	tlsCertPath  = "/certs/server.crt"
+	tlsKeyPath   = "/certs/server.key"
+)
+
+// RegistryContainer represents the Registry container type used in the module
+type RegistryContainer struct {
+	testcontainers.Container
+	username   string
+	password   string
+	tlsEnabled bool
+}
+
+// RunContainer creates an instance of the Registry container type, running a Docker Registry
+// with its storage in the filesystem of the container.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*RegistryContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "registry:2.8.3",
+		ExposedPorts: []string{registryPort},
+		Env: map[string]string{
+			"REGISTRY_STORAGE_FILESYSTEM_ROOTDIRECTORY": dataPath,
+			// allows removing images from the registry
+			"REGISTRY_STORAGE_DELETE_ENABLED": "true",
+		},
+		WaitingFor: wait.ForHTTP("/").WithPort(registryPort),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	tlsEnabled := settings.TLSCertFile != "" || settings.TLSKeyFile != ""
+	if tlsEnabled {
+		if settings.TLSCertFile == "" || settings.TLSKeyFile == "" {
+			return nil, errors.New("both the TLS certificate and key files are required")
+		}
+
+		genericContainerReq.Env["REGISTRY_HTTP_TLS_CERTIFICATE"] = tlsCertPath
+		genericContainerReq.Env["REGISTRY_HTTP_TLS_KEY"] = tlsKeyPath
+		genericContainerReq.Files = append(genericContainerReq.Files,
+			testcontainers.ContainerFile{HostFilePath: settings.TLSCertFile, ContainerFilePath: tlsCertPath, FileMode: 0o644},
+			testcontainers.ContainerFile{HostFilePath: settings.TLSKeyFile, ContainerFilePath: tlsKeyPath, FileMode: 0o644},
+		)
+		genericContainerReq.WaitingFor = wait.ForHTTP("/").WithPort(registryPort).WithTLS(true).WithAllowInsecure(true)
+	}
+
+	if settings.DataPath != "" {
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      settings.DataPath,
+			ContainerFilePath: dataPath,
+		})
+	}
+
+	var htpasswd []byte
+	var username, password string
+	if len(settings.Users) > 0 {
+		var err error
+		htpasswd, err = htpasswdFile(settings.Users)
+		if err != nil {
+			return nil, err
+		}
+
+		usernames := sortedUsernames(settings.Users)
+		username, password = usernames[0], settings.Users[usernames[0]]
+
+		genericContainerReq.Env["REGISTRY_AUTH"] = "htpasswd"
+		genericContainerReq.Env["REGISTRY_AUTH_HTPASSWD_REALM"] = "Registry"
+		genericContainerReq.Env["REGISTRY_AUTH_HTPASSWD_PATH"] = htpasswdPath
+
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					if err := c.CopyToContainer(ctx, htpasswd, htpasswdPath, 0o644); err != nil {
+						return fmt.Errorf("copy htpasswd file: %w", err)
+					}
+
+					return nil
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegistryContainer{
+		Container:  container,
+		username:   username,
+		password:   password,
+		tlsEnabled: tlsEnabled,
+	}, nil
+}
+
+// htpasswdFile returns the content of an htpasswd file for the given users,
+// with the passwords hashed with bcrypt, the only format supported by the registry.
+func htpasswdFile(users map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, username := range sortedUsernames(users) {
+		if username == "" || strings.ContainsAny(username, ":\n") {
+			return nil, fmt.Errorf("invalid username: %q", username)
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(users[username]), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("hash password: %w", err)
+		}
+
+		buf.WriteString(username + ":" + string(hash) + "\n")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func sortedUsernames(users map[string]string) []string {
+	usernames := make([]string, 0, len(users))
+	for username := range users {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+
+	return usernames
+}
+
+// Address returns the "host:port" address of the registry, e.g. "localhost:32768",
+// which is the registry domain of the images stored in it.
+func (c *RegistryContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, registryPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// URL returns the URL of the registry API, e.g. "http://localhost:32768",
+// using the "https" scheme if TLS is enabled with the WithTLS option.
+func (c *RegistryContainer) URL(ctx context.Context) (string, error) {
+	address, err := c.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scheme := "http"
+	if c.tlsEnabled {
+		scheme = "https"
+	}
+
+	return scheme + "://" + address, nil
+}
+
+// ImageRef rewrites the registry domain of the given image reference with the address of the registry,
+// e.g. "redis:7" and "docker.io/library/redis:7" become "localhost:32768/redis:7" and "localhost:32768/library/redis:7".
+func (c *RegistryContainer) ImageRef(ctx context.Context, ref string) (string, error) {
+	address, err := c.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return rewriteImageRef(ref, address), nil
+}
+
+// rewriteImageRef replaces the registry domain of the image reference, if any, with the given address.
+// Like Docker, the first component of the reference is a registry domain if it contains a "." or a ":",
+// or if it's "localhost".
+func rewriteImageRef(ref string, address string) string {
+	if i := strings.Index(ref, "/"); i >= 0 {
+		domain := ref[:i]
+		if strings.ContainsAny(domain, ".:") || domain == "localhost" {
+			ref = ref[i+1:]
+		}
+	}
+
+	return address + "/" + ref
+}
+
+// PushImage tags the given image, which must exist in the local Docker cache, with the address
+// of the registry, and pushes it to the registry, using the credentials of the first user
+// if the authentication is enabled with the WithUser option. It returns the reference of the pushed image,
+// e.g. "localhost:32768/redis:7" for "redis:7", to be used as the image of other containers.
+func (c *RegistryContainer) PushImage(ctx context.Context, ref string) (string, error) {
+	address, err := c.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	target := rewriteImageRef(ref, address)
+
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	if err := cli.ImageTag(ctx, ref, target); err != nil {
+		return "", fmt.Errorf("failed to tag image %s: %w", ref, err)
+	}
+
+	auth, err := registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      c.username,
+		Password:      c.password,
+		ServerAddress: address,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode registry auth: %w", err)
+	}
+
+	output, err := cli.ImagePush(ctx, target, types.ImagePushOptions{RegistryAuth: auth})
+	if err != nil {
+		return "", fmt.Errorf("failed to push image %s: %w", target, err)
+	}
+	defer output.Close()
+
+	// the errors of the push are reported in the output stream
+	if err := jsonmessage.DisplayJSONMessagesStream(output, io.Discard, 0, false, nil); err != nil {
+		return "", fmt.Errorf("failed to push image %s: %w", target, err)
+	}
+
+	return target, nil
+}
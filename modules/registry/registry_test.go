@@ -0,0 +1,145 @@
+package registry_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/registry"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := registry.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	pullImage(t, "redis:5.0-alpine")
+
+	// pushImage {
+	image, err := container.PushImage(ctx, "redis:5.0-alpine")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// imageRef {
+	ref, err := container.ImageRef(ctx, "docker.io/redis:5.0-alpine")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image != ref {
+		t.Fatalf("expected %s to be %s", image, ref)
+	}
+
+	redisC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:           image,
+			AlwaysPullImage: true, // make sure the image is pulled from the registry
+			WaitingFor:      wait.ForLog("Ready to accept connections"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := redisC.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+}
+
+func TestRegistryWithUser(t *testing.T) {
+	ctx := context.Background()
+
+	// withUser {
+	container, err := registry.RunContainer(ctx, registry.WithUser("testuser", "testpassword"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	pullImage(t, "redis:5.0-alpine")
+
+	if _, err := container.PushImage(ctx, "redis:5.0-alpine"); err != nil {
+		t.Fatal(err)
+	}
+
+	registryURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, registryURL+"/v2/_catalog", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status code %d without credentials, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	req.SetBasicAuth("testuser", "testpassword")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d with credentials, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var catalog struct {
+		Repositories []string `json:"repositories"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&catalog); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(catalog.Repositories) != 1 || catalog.Repositories[0] != "redis" {
+		t.Fatalf("expected the redis repository, got %v", catalog.Repositories)
+	}
+}
+
+func pullImage(t *testing.T, image string) {
+	t.Helper()
+
+	provider, err := testcontainers.NewDockerProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close()
+
+	if err := provider.PullImage(context.Background(), image); err != nil {
+		t.Fatal(err)
+	}
+}
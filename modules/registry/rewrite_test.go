@@ -0,0 +1,26 @@
+package registry
+
+import "testing"
+
+func TestRewriteImageRef(t *testing.T) {
+	tests := []struct {
+		ref      string
+		expected string
+	}{
+		{ref: "redis", expected: "localhost:5000/redis"},
+		{ref: "redis:7", expected: "localhost:5000/redis:7"},
+		{ref: "library/redis:7", expected: "localhost:5000/library/redis:7"},
+		{ref: "docker.io/library/redis:7", expected: "localhost:5000/library/redis:7"},
+		{ref: "localhost/redis:7", expected: "localhost:5000/redis:7"},
+		{ref: "localhost:5001/redis:7", expected: "localhost:5000/redis:7"},
+		{ref: "ghcr.io/org/image@sha256:abc", expected: "localhost:5000/org/image@sha256:abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			if got := rewriteImageRef(tt.ref, "localhost:5000"); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package rethinkdb_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go/modules/rethinkdb"
+)
+
+func ExampleRunContainer() {
+	// runRethinkDBContainer {
+	ctx := context.Background()
+
+	rethinkdbContainer, err := rethinkdb.RunContainer(ctx, rethinkdb.WithPassword("s3cr3t"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := rethinkdbContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := rethinkdbContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,33 @@
+package rethinkdb
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Password is the initial password of the admin account.
+	Password string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the RethinkDB container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithPassword sets the initial password of the admin account, which has no password by default.
+// The password is only set when the data directory is created, so it's ignored for existing data.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.Password = password
+	}
+}
@@ -0,0 +1,98 @@
+package rethinkdb
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	driverPort = "28015/tcp"
+	adminPort  = "8080/tcp"
+)
+
+// DefaultUser is the user of the admin account.
+const DefaultUser = "admin"
+
+// RethinkDBContainer represents the RethinkDB container type used in the module
+type RethinkDBContainer struct {
+	testcontainers.Container
+	password string
+}
+
+// RunContainer creates an instance of the RethinkDB container type, listening on all the addresses.
+// The admin account has no password, unless it's set with the WithPassword option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*RethinkDBContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "rethinkdb:2.4.3",
+		ExposedPorts: []string{driverPort, adminPort},
+		Cmd:          []string{"rethinkdb", "--bind", "all"},
+		WaitingFor: wait.ForAll(
+			wait.ForLog("Server ready"),
+			wait.ForListeningPort(driverPort),
+		).WithDeadline(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.Password != "" {
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--initial-password", settings.Password)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RethinkDBContainer{Container: container, password: settings.Password}, nil
+}
+
+// Password returns the password of the admin account, empty by default.
+func (c *RethinkDBContainer) Password() string {
+	return c.password
+}
+
+// DriverAddress returns the "host:port" address of the client driver port, to be used by the RethinkDB drivers.
+func (c *RethinkDBContainer) DriverAddress(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, driverPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// AdminURL returns the base URL of the web administration console, e.g. "http://localhost:32768".
+func (c *RethinkDBContainer) AdminURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, adminPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
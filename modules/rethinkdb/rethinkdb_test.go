@@ -0,0 +1,248 @@
+package rethinkdb_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/rethinkdb"
+)
+
+func TestRethinkDB(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := rethinkdb.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// driverAddress {
+	address, err := container.DriverAddress(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the admin account has no password by default
+	if err := authenticate(address, rethinkdb.DefaultUser, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// adminURL {
+	adminURL, err := container.AdminURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(adminURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the admin console", resp.StatusCode)
+	}
+}
+
+func TestRethinkDBWithPassword(t *testing.T) {
+	ctx := context.Background()
+
+	// withPassword {
+	container, err := rethinkdb.RunContainer(ctx, rethinkdb.WithPassword("s3cr3t"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	address, err := container.DriverAddress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := authenticate(address, rethinkdb.DefaultUser, container.Password()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := authenticate(address, rethinkdb.DefaultUser, ""); err == nil {
+		t.Fatal("expected the authentication without password to fail")
+	}
+}
+
+// authenticate performs the V1_0 handshake of the RethinkDB client driver protocol, which authenticates
+// the user with SCRAM-SHA-256, avoiding a dependency on a RethinkDB driver.
+func authenticate(address string, user string, password string) error {
+	conn, err := net.DialTimeout("tcp", address, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(10 * time.Second)); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(conn)
+
+	magic := make([]byte, 4)
+	binary.LittleEndian.PutUint32(magic, 0x34c2bdc3)
+	if _, err := conn.Write(magic); err != nil {
+		return err
+	}
+
+	var hello struct {
+		Success bool `json:"success"`
+	}
+	if err := readMessage(r, &hello); err != nil {
+		return err
+	}
+	if !hello.Success {
+		return errors.New("the server rejected the protocol version")
+	}
+
+	nonce := make([]byte, 18)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	clientFirstBare := "n=" + user + ",r=" + base64.StdEncoding.EncodeToString(nonce)
+	if err := writeMessage(conn, map[string]interface{}{
+		"protocol_version":      0,
+		"authentication_method": "SCRAM-SHA-256",
+		"authentication":        "n,," + clientFirstBare,
+	}); err != nil {
+		return err
+	}
+
+	serverFirst, err := readAuthentication(r)
+	if err != nil {
+		return err
+	}
+
+	attrs := map[string]string{}
+	for _, attr := range strings.Split(serverFirst, ",") {
+		if k, v, ok := strings.Cut(attr, "="); ok {
+			attrs[k] = v
+		}
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(attrs["s"])
+	if err != nil {
+		return err
+	}
+
+	iterations, err := strconv.Atoi(attrs["i"])
+	if err != nil {
+		return err
+	}
+
+	saltedPassword := pbkdf2SHA256([]byte(password), salt, iterations)
+	clientKey := hmacSHA256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+
+	clientFinal := "c=biws,r=" + attrs["r"]
+	authMessage := clientFirstBare + "," + serverFirst + "," + clientFinal
+	clientSignature := hmacSHA256(storedKey[:], []byte(authMessage))
+
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	if err := writeMessage(conn, map[string]interface{}{
+		"authentication": clientFinal + ",p=" + base64.StdEncoding.EncodeToString(proof),
+	}); err != nil {
+		return err
+	}
+
+	_, err = readAuthentication(r)
+	return err
+}
+
+// readAuthentication reads a message of the authentication exchange, returning its "authentication" field.
+func readAuthentication(r *bufio.Reader) (string, error) {
+	var msg struct {
+		Success        bool   `json:"success"`
+		Authentication string `json:"authentication"`
+		Error          string `json:"error"`
+	}
+	if err := readMessage(r, &msg); err != nil {
+		return "", err
+	}
+
+	if !msg.Success {
+		return "", fmt.Errorf("authentication failed: %s", msg.Error)
+	}
+
+	return msg.Authentication, nil
+}
+
+// readMessage reads a null-terminated JSON message of the handshake.
+func readMessage(r *bufio.Reader, v interface{}) error {
+	msg, err := r.ReadBytes(0)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(msg[:len(msg)-1], v)
+}
+
+// writeMessage writes a null-terminated JSON message of the handshake.
+func writeMessage(conn net.Conn, v interface{}) error {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Write(append(msg, 0))
+	return err
+}
+
+func hmacSHA256(key []byte, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// pbkdf2SHA256 derives a key of the size of a SHA-256 hash, which only needs the first block of PBKDF2.
+func pbkdf2SHA256(password []byte, salt []byte, iterations int) []byte {
+	u := hmacSHA256(password, append(append([]byte{}, salt...), 0, 0, 0, 1))
+
+	key := make([]byte, len(u))
+	copy(key, u)
+	for i := 1; i < iterations; i++ {
+		u = hmacSHA256(password, u)
+		for j := range key {
+			key[j] ^= u[j]
+		}
+	}
+
+	return key
+}
@@ -0,0 +1,37 @@
+package risingwave_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/risingwave"
+)
+
+func ExampleRunContainer() {
+	// runRisingWaveContainer {
+	ctx := context.Background()
+
+	risingwaveContainer, err := risingwave.RunContainer(ctx, testcontainers.WithImage("risingwavelabs/risingwave:v1.6.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := risingwaveContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := risingwaveContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,86 @@
+package risingwave
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	// DefaultUser is the superuser created by the playground.
+	DefaultUser = "root"
+	// DefaultDatabase is the database created by the playground.
+	DefaultDatabase = "dev"
+
+	sqlPort = "4566/tcp"
+)
+
+// RisingWaveContainer represents the RisingWave container type used in the module
+type RisingWaveContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the RisingWave container type, running the playground mode,
+// where all the components run in a single process with the state stored in memory. RisingWave is
+// compatible with the PostgreSQL wire protocol, so the PostgreSQL drivers and tools can be used to
+// create sources, materialized views and sinks, and to query them with plain SQL.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*RisingWaveContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "risingwavelabs/risingwave:v1.6.0",
+		ExposedPorts: []string{sqlPort},
+		Cmd:          []string{"playground"},
+		WaitingFor:   wait.ForListeningPort(sqlPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RisingWaveContainer{Container: container}, nil
+}
+
+// ConnectionString returns the URL to connect to the default database as the default user, e.g.
+// "postgres://root@localhost:32768/dev?sslmode=disable", to be used by the PostgreSQL drivers.
+// The extra arguments are appended as query parameters, and TLS is disabled unless "sslmode" is set.
+func (c *RisingWaveContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, sqlPort)
+	if err != nil {
+		return "", err
+	}
+
+	query := strings.Join(args, "&")
+	if !strings.Contains(query, "sslmode=") {
+		// the playground does not support TLS
+		query = strings.TrimPrefix(query+"&sslmode=disable", "&")
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.User(DefaultUser),
+		Host:     net.JoinHostPort(host, port.Port()),
+		Path:     "/" + DefaultDatabase,
+		RawQuery: query,
+	}
+
+	return u.String(), nil
+}
@@ -0,0 +1,62 @@
+package risingwave_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/lib/pq"
+
+	"github.com/testcontainers/testcontainers-go/modules/risingwave"
+)
+
+func TestRisingWave(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := risingwave.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx, "application_name=test")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	statements := []string{
+		"CREATE TABLE clicks (country VARCHAR, clicks INT)",
+		"CREATE MATERIALIZED VIEW clicks_by_country AS SELECT country, SUM(clicks) AS clicks FROM clicks GROUP BY country",
+		"INSERT INTO clicks VALUES ('es', 3), ('fr', 5), ('es', 4)",
+		// the inserted rows are visible to the queries once they are flushed
+		"FLUSH",
+	}
+	for _, stmt := range statements {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("failed to execute %q: %s", stmt, err)
+		}
+	}
+
+	var clicks int64
+	if err := db.QueryRowContext(ctx, "SELECT clicks FROM clicks_by_country WHERE country = 'es'").Scan(&clicks); err != nil {
+		t.Fatal(err)
+	}
+
+	if clicks != 7 {
+		t.Fatalf("expected 7 clicks for es, got %d", clicks)
+	}
+}
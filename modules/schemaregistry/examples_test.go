@@ -0,0 +1,65 @@
+package schemaregistry_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/kafka"
+	"github.com/testcontainers/testcontainers-go/modules/schemaregistry"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+func ExampleRunContainer() {
+	// runSchemaRegistryContainer {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := nw.Remove(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	kafkaContainer, err := kafka.RunContainer(ctx, network.WithNetwork([]string{"kafka"}, nw))
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err := kafkaContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+
+	schemaRegistryContainer, err := schemaregistry.RunContainer(ctx,
+		testcontainers.WithImage("confluentinc/cp-schema-registry:7.5.0"),
+		network.WithNetwork([]string{"schema-registry"}, nw),
+		schemaregistry.WithBootstrapServers("kafka:9092"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := schemaRegistryContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := schemaRegistryContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,193 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	restPort = "8081/tcp"
+
+	contentType = "application/vnd.schemaregistry.v1+json"
+)
+
+// SchemaType is the type of a schema.
+type SchemaType string
+
+const (
+	SchemaTypeAvro     SchemaType = "AVRO"
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+	SchemaTypeJSON     SchemaType = "JSON"
+)
+
+// SchemaRegistryContainer represents the Schema Registry container type used in the module
+type SchemaRegistryContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Schema Registry container type, which stores the schemas
+// in a Kafka cluster. The container must join the network of the cluster, using network.WithNetwork,
+// and the bootstrap servers of the cluster must be set with the WithBootstrapServers option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SchemaRegistryContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "confluentinc/cp-schema-registry:7.5.0",
+		ExposedPorts: []string{restPort},
+		Env: map[string]string{
+			"SCHEMA_REGISTRY_HOST_NAME": "schema-registry",
+			"SCHEMA_REGISTRY_LISTENERS": "http://0.0.0.0:8081",
+		},
+		WaitingFor: wait.ForHTTP("/subjects").WithPort(restPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	if genericContainerReq.Env["SCHEMA_REGISTRY_KAFKASTORE_BOOTSTRAP_SERVERS"] == "" {
+		return nil, errors.New("the bootstrap servers are required, use the WithBootstrapServers option")
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaRegistryContainer{Container: container}, nil
+}
+
+// WithBootstrapServers sets the bootstrap servers of the Kafka cluster, e.g. "kafka:9092",
+// which must be reachable from the network of the container. The servers without a protocol
+// are reached with the PLAINTEXT one.
+func WithBootstrapServers(servers ...string) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) {
+		bootstrapServers := make([]string, len(servers))
+		for i, server := range servers {
+			if !strings.Contains(server, "://") {
+				server = "PLAINTEXT://" + server
+			}
+			bootstrapServers[i] = server
+		}
+
+		req.Env["SCHEMA_REGISTRY_KAFKASTORE_BOOTSTRAP_SERVERS"] = strings.Join(bootstrapServers, ",")
+	}
+}
+
+// URL returns the URL of the REST API of the Schema Registry, e.g. "http://localhost:32768",
+// to be used by the serializers of the Kafka clients.
+func (c *SchemaRegistryContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// RegisterSchema registers a schema of the given type under the given subject, returning the ID of the schema.
+// Registering the same schema again returns the same ID.
+func (c *SchemaRegistryContainer) RegisterSchema(ctx context.Context, subject string, schemaType SchemaType, schema string) (int, error) {
+	payload := map[string]string{"schema": schema}
+	// AVRO is the default type, and the older versions of the Schema Registry don't support the field
+	if schemaType != SchemaTypeAvro {
+		payload["schemaType"] = string(schemaType)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	respBody, err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject)+"/versions", body)
+	if err != nil {
+		return 0, err
+	}
+
+	var registered struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &registered); err != nil {
+		return 0, fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	return registered.ID, nil
+}
+
+// RegisterAvroSchema registers an Avro schema under the given subject, returning the ID of the schema.
+func (c *SchemaRegistryContainer) RegisterAvroSchema(ctx context.Context, subject string, schema string) (int, error) {
+	return c.RegisterSchema(ctx, subject, SchemaTypeAvro, schema)
+}
+
+// RegisterProtobufSchema registers a Protobuf schema, the content of a .proto file, under the given subject,
+// returning the ID of the schema.
+func (c *SchemaRegistryContainer) RegisterProtobufSchema(ctx context.Context, subject string, schema string) (int, error) {
+	return c.RegisterSchema(ctx, subject, SchemaTypeProtobuf, schema)
+}
+
+// Subjects returns the subjects registered in the Schema Registry.
+func (c *SchemaRegistryContainer) Subjects(ctx context.Context) ([]string, error) {
+	respBody, err := c.do(ctx, http.MethodGet, "/subjects", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var subjects []string
+	if err := json.Unmarshal(respBody, &subjects); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	return subjects, nil
+}
+
+// do sends a request to the REST API, returning the body of the response,
+// or an error if the status code is not 200 OK.
+func (c *SchemaRegistryContainer) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, string(respBody))
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,104 @@
+package schemaregistry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/kafka"
+	"github.com/testcontainers/testcontainers-go/modules/schemaregistry"
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+const userSchema = `{
+	"type": "record",
+	"name": "User",
+	"fields": [{"name": "name", "type": "string"}]
+}`
+
+const orderSchema = `syntax = "proto3";
+message Order {
+	string id = 1;
+}`
+
+func TestSchemaRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	kafkaContainer, err := kafka.RunContainer(ctx, network.WithNetwork([]string{"kafka"}, nw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := kafkaContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// withBootstrapServers {
+	container, err := schemaregistry.RunContainer(ctx,
+		network.WithNetwork([]string{"schema-registry"}, nw),
+		// the internal listener of the Kafka container
+		schemaregistry.WithBootstrapServers("kafka:9092"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// registerSchemas {
+	avroID, err := container.RegisterAvroSchema(ctx, "users-value", userSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	protobufID, err := container.RegisterProtobufSchema(ctx, "orders-value", orderSchema)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if avroID == protobufID {
+		t.Fatalf("expected different IDs, got %d", avroID)
+	}
+
+	id, err := container.RegisterAvroSchema(ctx, "users-value", userSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != avroID {
+		t.Fatalf("expected the same ID %d for the same schema, got %d", avroID, id)
+	}
+
+	subjects, err := container.Subjects(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subjects) != 2 {
+		t.Fatalf("expected 2 subjects, got %v", subjects)
+	}
+}
+
+func TestSchemaRegistryWithoutBootstrapServers(t *testing.T) {
+	_, err := schemaregistry.RunContainer(context.Background())
+	if err == nil {
+		t.Fatal("expected an error without the bootstrap servers")
+	}
+}
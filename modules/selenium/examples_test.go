@@ -0,0 +1,37 @@
+package selenium_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/selenium"
+)
+
+func ExampleRunContainer() {
+	// runSeleniumContainer {
+	ctx := context.Background()
+
+	seleniumContainer, err := selenium.RunContainer(ctx, testcontainers.WithImage("selenium/standalone-chrome:4.17.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := seleniumContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := seleniumContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,47 @@
+package selenium
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Recording is a flag to record the screen of the browser, using a sidecar container.
+	Recording bool
+	// RecorderImage is the image of the sidecar container recording the screen.
+	RecorderImage string
+}
+
+func defaultOptions() options {
+	return options{
+		RecorderImage: defaultRecorderImage,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Selenium container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithRecording records the screen of the browser, through its VNC server, in a sidecar container
+// attached to a network created by the module. The video can be saved with the SaveRecording method
+// of the container, once the browser sessions are finished.
+func WithRecording() Option {
+	return func(o *options) {
+		o.Recording = true
+	}
+}
+
+// WithRecorderImage sets the image of the sidecar container recording the screen,
+// which must be compatible with the "selenium/video" images. It implies WithRecording.
+func WithRecorderImage(image string) Option {
+	return func(o *options) {
+		o.Recording = true
+		o.RecorderImage = image
+	}
+}
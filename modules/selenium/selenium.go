@@ -0,0 +1,220 @@
+package selenium
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultRecorderImage = "selenium/video:ffmpeg-6.1-20240123"
+
+	webDriverPort = "4444/tcp"
+	vncPort       = "5900/tcp"
+	noVNCPort     = "7900/tcp"
+
+	// defaultShmSize is the size of /dev/shm, as the default 64MB makes Chrome crash.
+	defaultShmSize = 2 * 1024 * 1024 * 1024
+
+	// DefaultVNCPassword is the password of the VNC server of the Selenium images.
+	DefaultVNCPassword = "secret"
+
+	browserAlias  = "browser"
+	recordingPath = "/videos/video.mp4"
+)
+
+// SeleniumContainer represents the Selenium container type used in the module
+type SeleniumContainer struct {
+	testcontainers.Container
+	recorder testcontainers.Container
+	network  *testcontainers.DockerNetwork
+}
+
+// RunContainer creates an instance of the Selenium container type, running a standalone browser,
+// e.g. Chrome, Firefox or Edge, depending on the image. By default, the Chrome image is used.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SeleniumContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "selenium/standalone-chrome:4.17.0",
+		ExposedPorts: []string{webDriverPort, vncPort, noVNCPort},
+		HostConfigModifier: func(hc *container.HostConfig) {
+			hc.ShmSize = defaultShmSize
+		},
+		WaitingFor: wait.ForHTTP("/status").WithPort(webDriverPort).WithResponseMatcher(func(body io.Reader) bool {
+			var status struct {
+				Value struct {
+					Ready bool `json:"ready"`
+				} `json:"value"`
+			}
+			if err := json.NewDecoder(body).Decode(&status); err != nil {
+				return false
+			}
+			return status.Value.Ready
+		}),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	c := &SeleniumContainer{}
+
+	if settings.Recording {
+		nw, err := network.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create network: %w", err)
+		}
+		c.network = nw
+
+		network.WithNetwork([]string{browserAlias}, nw)(&genericContainerReq)
+	}
+
+	browser, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		c.removeNetwork(ctx)
+		return nil, err
+	}
+	c.Container = browser
+
+	if settings.Recording {
+		recorder, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+			ContainerRequest: testcontainers.ContainerRequest{
+				Image: settings.RecorderImage,
+				Env: map[string]string{
+					"DISPLAY_CONTAINER_NAME": browserAlias,
+					"SE_VIDEO_FILE_NAME":     "video.mp4",
+				},
+				Networks: []string{c.network.Name},
+				WaitingFor: wait.ForExec([]string{"test", "-f", recordingPath}).
+					WithStartupTimeout(30 * time.Second),
+			},
+			Started: true,
+		})
+		if err != nil {
+			_ = c.Terminate(ctx)
+			return nil, fmt.Errorf("failed to start the recorder: %w", err)
+		}
+		c.recorder = recorder
+	}
+
+	return c, nil
+}
+
+// WebDriverURL returns the URL of the WebDriver endpoint, e.g. "http://localhost:32768/wd/hub",
+// to be used as the remote URL of the WebDriver clients.
+func (c *SeleniumContainer) WebDriverURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, webDriverPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s/wd/hub", address), nil
+}
+
+// VNCAddress returns the "host:port" address of the VNC server, whose password is DefaultVNCPassword.
+func (c *SeleniumContainer) VNCAddress(ctx context.Context) (string, error) {
+	return c.address(ctx, vncPort)
+}
+
+// NoVNCURL returns the URL of the noVNC web client, e.g. "http://localhost:32768/?autoconnect=1&password=secret",
+// to watch the browser from a web browser.
+func (c *SeleniumContainer) NoVNCURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, noVNCPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s/?autoconnect=1&resize=scale&password=%s", address, DefaultVNCPassword), nil
+}
+
+// SaveRecording stops the recording of the screen, and saves the video in the given path of the host,
+// in the MP4 format. It returns an error if the recording is not enabled with the WithRecording option.
+// The recording cannot be resumed once saved.
+func (c *SeleniumContainer) SaveRecording(ctx context.Context, hostPath string) error {
+	if c.recorder == nil {
+		return fmt.Errorf("the recording is not enabled")
+	}
+
+	// the recorder finishes writing the video when it's stopped
+	timeout := 10 * time.Second
+	if err := c.recorder.Stop(ctx, &timeout); err != nil {
+		return fmt.Errorf("failed to stop the recorder: %w", err)
+	}
+
+	reader, err := c.recorder.CopyFileFromContainer(ctx, recordingPath)
+	if err != nil {
+		return fmt.Errorf("failed to copy the recording: %w", err)
+	}
+	defer reader.Close()
+
+	f, err := os.Create(hostPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return fmt.Errorf("failed to save the recording: %w", err)
+	}
+
+	return nil
+}
+
+// Terminate terminates the browser container, and the recorder container and the network
+// if the recording is enabled.
+func (c *SeleniumContainer) Terminate(ctx context.Context) error {
+	if c.recorder != nil {
+		if err := c.recorder.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Container != nil {
+		if err := c.Container.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	return c.removeNetwork(ctx)
+}
+
+func (c *SeleniumContainer) removeNetwork(ctx context.Context) error {
+	if c.network == nil {
+		return nil
+	}
+
+	return c.network.Remove(ctx)
+}
+
+func (c *SeleniumContainer) address(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
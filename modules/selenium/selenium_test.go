@@ -0,0 +1,177 @@
+package selenium_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/selenium"
+)
+
+func TestSelenium(t *testing.T) {
+	tests := []struct {
+		name    string
+		image   string
+		browser string
+	}{
+		{name: "chrome", image: "selenium/standalone-chrome:4.17.0", browser: "chrome"},
+		{name: "firefox", image: "selenium/standalone-firefox:4.17.0", browser: "firefox"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			container, err := selenium.RunContainer(ctx, testcontainers.WithImage(tt.image))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Clean up the container after the test is complete
+			t.Cleanup(func() {
+				if err := container.Terminate(ctx); err != nil {
+					t.Fatalf("failed to terminate container: %s", err)
+				}
+			})
+
+			// webDriverURL {
+			webDriverURL, err := container.WebDriverURL(ctx)
+			// }
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sessionURL := newSession(t, webDriverURL, tt.browser)
+			deleteSession(t, sessionURL)
+		})
+	}
+}
+
+func TestSeleniumWithRecording(t *testing.T) {
+	ctx := context.Background()
+
+	// withRecording {
+	container, err := selenium.RunContainer(ctx, selenium.WithRecording())
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the containers and the network after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	webDriverURL, err := container.WebDriverURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessionURL := newSession(t, webDriverURL, "chrome")
+	deleteSession(t, sessionURL)
+
+	// saveRecording {
+	videoPath := filepath.Join(t.TempDir(), "video.mp4")
+
+	err = container.SaveRecording(ctx, videoPath)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(videoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Size() == 0 {
+		t.Fatal("expected a non-empty video")
+	}
+}
+
+func TestSeleniumSaveRecordingWithoutRecording(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := selenium.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if err := container.SaveRecording(ctx, filepath.Join(t.TempDir(), "video.mp4")); err == nil {
+		t.Fatal("expected an error when the recording is not enabled")
+	}
+}
+
+// newSession creates a WebDriver session for the given browser, returning the URL of the session.
+func newSession(t *testing.T, webDriverURL string, browser string) string {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"alwaysMatch": map[string]interface{}{
+				"browserName": browser,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Post(webDriverURL+"/session", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code creating the session: %d", resp.StatusCode)
+	}
+
+	var session struct {
+		Value struct {
+			SessionID string `json:"sessionId"`
+		} `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		t.Fatal(err)
+	}
+
+	if session.Value.SessionID == "" {
+		t.Fatal("expected a session id")
+	}
+
+	return fmt.Sprintf("%s/session/%s", webDriverURL, session.Value.SessionID)
+}
+
+func deleteSession(t *testing.T, sessionURL string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodDelete, sessionURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code deleting the session: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,37 @@
+package sftp_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/sftp"
+)
+
+func ExampleRunContainer() {
+	// runSFTPContainer {
+	ctx := context.Background()
+
+	sftpContainer, err := sftp.RunContainer(ctx, testcontainers.WithImage("atmoz/sftp:alpine"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := sftpContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := sftpContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,77 @@
+package sftp
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Users are the users of the SFTP server, in the order they are added.
+	Users []*user
+}
+
+type user struct {
+	Name     string
+	Password string
+	Keys     []string
+	Dirs     []mountedDir
+}
+
+type mountedDir struct {
+	HostDir      string
+	ContainerDir string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// user returns the user with the given name, adding it if needed.
+func (o *options) user(name string) *user {
+	for _, u := range o.Users {
+		if u.Name == name {
+			return u
+		}
+	}
+
+	u := &user{Name: name}
+	o.Users = append(o.Users, u)
+	return u
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the SFTP container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUser adds a user authenticated with the given password. It can be called multiple times.
+func WithUser(name string, password string) Option {
+	return func(o *options) {
+		o.user(name).Password = password
+	}
+}
+
+// WithUserKey authorizes the given public key, in the authorized_keys format, to authenticate the user,
+// which is added without a password if it's not added with WithUser. It can be called multiple times.
+func WithUserKey(name string, publicKey string) Option {
+	return func(o *options) {
+		u := o.user(name)
+		u.Keys = append(u.Keys, publicKey)
+	}
+}
+
+// WithMountedDir mounts the given host directory in the given directory of the home of the user, e.g. "inbox"
+// for /home/foo/inbox, so the files transferred by the tests can be checked on the host. The host directory
+// must be writable by the user of the container, whose uid is 1000 for the first user, 1001 for the second, etc.
+// It can be called multiple times.
+func WithMountedDir(name string, hostDir string, containerDir string) Option {
+	return func(o *options) {
+		u := o.user(name)
+		u.Dirs = append(u.Dirs, mountedDir{HostDir: hostDir, ContainerDir: containerDir})
+	}
+}
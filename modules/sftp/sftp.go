@@ -0,0 +1,123 @@
+package sftp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	sshPort = "22/tcp"
+
+	firstUID = 1000
+)
+
+// DefaultDir is the directory created in the home of the users, which is writable by them,
+// as their home directory is owned by root to chroot them.
+const DefaultDir = "upload"
+
+// SFTPContainer represents the SFTP container type used in the module
+type SFTPContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the SFTP container type, running an OpenSSH server which only
+// allows the SFTP protocol, with the users set with the WithUser and WithUserKey options. The users are
+// chrooted to their home directory, e.g. /home/foo, and their files are written to the DefaultDir
+// directory, or to the directories mounted with the WithMountedDir option.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SFTPContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "atmoz/sftp:alpine",
+		ExposedPorts: []string{sshPort},
+		WaitingFor:   wait.ForListeningPort(sshPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	var binds []string
+	var keys []userKey
+	for i, u := range settings.Users {
+		// the format of the users is user:pass[:e][:uid[:gid[:dir1[,dir2]...]]], in the users group
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, fmt.Sprintf("%s:%s:%d:100:%s", u.Name, u.Password, firstUID+i, DefaultDir))
+
+		for i, key := range u.Keys {
+			keys = append(keys, userKey{path: fmt.Sprintf("/home/%s/.ssh/keys/key-%d.pub", u.Name, i), content: key})
+		}
+
+		for _, dir := range u.Dirs {
+			binds = append(binds, dir.HostDir+":"+path.Join("/home", u.Name, dir.ContainerDir))
+		}
+	}
+
+	if len(keys) > 0 {
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					// the keys are appended to the authorized_keys file of the users when the container starts
+					for _, key := range keys {
+						if err := c.CopyToContainer(ctx, []byte(strings.TrimSpace(key.content)+"\n"), key.path, 0o644); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			},
+		})
+	}
+
+	if len(binds) > 0 {
+		hostConfigModifier := genericContainerReq.HostConfigModifier
+		genericContainerReq.HostConfigModifier = func(hostConfig *container.HostConfig) {
+			if hostConfigModifier != nil {
+				hostConfigModifier(hostConfig)
+			}
+			hostConfig.Binds = append(hostConfig.Binds, binds...)
+		}
+	}
+
+	c, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SFTPContainer{Container: c}, nil
+}
+
+type userKey struct {
+	path    string
+	content string
+}
+
+// Address returns the "host:port" address of the SFTP server, to be used by the SFTP clients.
+func (c *SFTPContainer) Address(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, sshPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
@@ -0,0 +1,129 @@
+package sftp_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/modules/sftp"
+)
+
+func TestSFTP(t *testing.T) {
+	ctx := context.Background()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the host directory is writable by the user of the container
+	inbox := t.TempDir()
+	if err := os.Chmod(inbox, 0o777); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(inbox, "report.csv"), []byte("id,amount\n1,42\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// withUsers {
+	container, err := sftp.RunContainer(ctx,
+		sftp.WithUser("foo", "pass"),
+		sftp.WithUserKey("bar", string(ssh.MarshalAuthorizedKey(signer.PublicKey()))),
+		sftp.WithMountedDir("foo", inbox, "inbox"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// address {
+	address, err := container.Address(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("password", func(t *testing.T) {
+		assertSFTPSession(t, address, "foo", ssh.Password("pass"))
+	})
+
+	t.Run("key", func(t *testing.T) {
+		assertSFTPSession(t, address, "bar", ssh.PublicKeys(signer))
+	})
+
+	t.Run("wrong-password", func(t *testing.T) {
+		_, err := ssh.Dial("tcp", address, clientConfig("foo", ssh.Password("wrong")))
+		if err == nil {
+			t.Fatal("expected an authentication error")
+		}
+	})
+
+	t.Run("mounted-dir", func(t *testing.T) {
+		code, reader, err := container.Exec(ctx, []string{"cat", "/home/foo/inbox/report.csv"}, tcexec.Multiplexed())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		output, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if code != 0 || !strings.Contains(string(output), "1,42") {
+			t.Fatalf("expected the file of the host directory, got exit code %d: %s", code, output)
+		}
+	})
+}
+
+// assertSFTPSession authenticates the user and opens an SFTP session.
+func assertSFTPSession(t *testing.T, address string, user string, auth ssh.AuthMethod) {
+	t.Helper()
+
+	client, err := ssh.Dial("tcp", address, clientConfig(user, auth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	if err := session.RequestSubsystem("sftp"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func clientConfig(user string, auth ssh.AuthMethod) *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User: user,
+		Auth: []ssh.AuthMethod{auth},
+		// the host keys are generated when the container starts
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         10 * time.Second,
+	}
+}
@@ -0,0 +1,167 @@
+package smocker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	mockPort  = "8080/tcp"
+	adminPort = "8081/tcp"
+)
+
+// SmockerContainer represents the Smocker container type used in the module
+type SmockerContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Smocker container type, serving the mocks on the mock server,
+// which are declared with the admin API.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SmockerContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "thiht/smocker:0.18.5",
+		ExposedPorts: []string{mockPort, adminPort},
+		WaitingFor: wait.ForAll(
+			wait.ForHTTP("/version").WithPort(adminPort),
+			wait.ForListeningPort(mockPort),
+		).WithDeadline(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&genericContainerReq)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SmockerContainer{Container: container}, nil
+}
+
+// MockURL returns the base URL of the mock server, e.g. "http://localhost:32768", to be used as the
+// URL of the mocked service by the code under test.
+func (c *SmockerContainer) MockURL(ctx context.Context) (string, error) {
+	return c.url(ctx, mockPort)
+}
+
+// AdminURL returns the base URL of the Smocker UI and admin API, e.g. "http://localhost:32768".
+func (c *SmockerContainer) AdminURL(ctx context.Context) (string, error) {
+	return c.url(ctx, adminPort)
+}
+
+// AddMocks adds the given mocks, in the YAML format of Smocker, to the current session.
+func (c *SmockerContainer) AddMocks(ctx context.Context, mocks string) error {
+	_, err := c.post(ctx, "/mocks", "application/x-yaml", mocks)
+	return err
+}
+
+// NewSession starts a new session with the given name, so the next mocks and calls are isolated
+// from the previous ones, which are still visible in the UI.
+func (c *SmockerContainer) NewSession(ctx context.Context, name string) error {
+	_, err := c.post(ctx, "/sessions?name="+url.QueryEscape(name), "", "")
+	return err
+}
+
+// Reset deletes all the sessions, with their mocks and their history, e.g. between two tests.
+func (c *SmockerContainer) Reset(ctx context.Context) error {
+	_, err := c.post(ctx, "/reset", "", "")
+	return err
+}
+
+// Verify checks the calls of the current session: the mocks with an expected number of calls must have been
+// called that many times, and all the calls must have matched a mock. It returns an error describing the failures.
+func (c *SmockerContainer) Verify(ctx context.Context) error {
+	body, err := c.post(ctx, "/sessions/verify", "", "")
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Mocks struct {
+			Verified bool   `json:"verified"`
+			Message  string `json:"message"`
+		} `json:"mocks"`
+		History struct {
+			Verified bool   `json:"verified"`
+			Message  string `json:"message"`
+		} `json:"history"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+
+	var errs []error
+	if !result.Mocks.Verified {
+		errs = append(errs, errors.New(result.Mocks.Message))
+	}
+	if !result.History.Verified {
+		errs = append(errs, errors.New(result.History.Message))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *SmockerContainer) post(ctx context.Context, path string, contentType string, body string) ([]byte, error) {
+	adminURL, err := c.AdminURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, adminURL+path, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, path, respBody)
+	}
+
+	return respBody, nil
+}
+
+func (c *SmockerContainer) url(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, mappedPort.Port()), nil
+}
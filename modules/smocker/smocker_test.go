@@ -0,0 +1,111 @@
+package smocker_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/smocker"
+)
+
+func TestSmocker(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := smocker.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// addMocks {
+	err = container.AddMocks(ctx, `
+- request:
+    method: GET
+    path: /users/42
+  response:
+    status: 200
+    headers:
+      Content-Type: application/json
+    body: '{"id": 42, "name": "Alice"}'
+  context:
+    times: 1
+`)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// mockURL {
+	mockURL, err := container.MockURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the mocked service is called as the code under test would do
+	if body := get(t, mockURL+"/users/42", http.StatusOK); body != `{"id": 42, "name": "Alice"}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	// verify {
+	err = container.Verify(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a call which doesn't match any mock fails the verification
+	get(t, mockURL+"/users/43", 666)
+	if err := container.Verify(ctx); err == nil {
+		t.Fatal("expected the verification to fail")
+	}
+
+	// reset {
+	err = container.Reset(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the mocks are deleted with the sessions
+	get(t, mockURL+"/users/42", 666)
+
+	// newSession {
+	err = container.NewSession(ctx, "second test")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := container.Verify(ctx); err != nil {
+		t.Fatalf("expected an empty session to be verified: %s", err)
+	}
+}
+
+func get(t *testing.T, url string, expectedStatus int) string {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		t.Fatalf("expected status code %d for %s, got %d: %s", expectedStatus, url, resp.StatusCode, body)
+	}
+
+	return string(body)
+}
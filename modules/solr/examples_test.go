@@ -0,0 +1,37 @@
+package solr_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/solr"
+)
+
+func ExampleRunContainer() {
+	// runSolrContainer {
+	ctx := context.Background()
+
+	solrContainer, err := solr.RunContainer(ctx, testcontainers.WithImage("solr:9.4.1"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := solrContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := solrContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,44 @@
+package solr
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Cores is the list of cores created once Solr is ready, in standalone mode.
+	Cores []string
+	// Collections is the list of collections created once Solr is ready, in SolrCloud mode.
+	Collections []string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Solr container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithCore creates cores with the given names, using the default configset, once Solr is ready.
+// It can be called multiple times, and it cannot be combined with WithCollection.
+func WithCore(names ...string) Option {
+	return func(o *options) {
+		o.Cores = append(o.Cores, names...)
+	}
+}
+
+// WithCollection runs Solr in SolrCloud mode, with an embedded ZooKeeper, and creates collections with
+// the given names, using the default configset, once Solr is ready. It can be called multiple times,
+// and it cannot be combined with WithCore.
+func WithCollection(names ...string) Option {
+	return func(o *options) {
+		o.Collections = append(o.Collections, names...)
+	}
+}
@@ -0,0 +1,159 @@
+package solr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "8983/tcp"
+
+// SolrContainer represents the Solr container type used in the module
+type SolrContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Solr container type, running in standalone mode,
+// or in SolrCloud mode, with an embedded ZooKeeper, if collections are created with WithCollection.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SolrContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "solr:9.4.1",
+		ExposedPorts: []string{httpPort},
+		WaitingFor:   wait.ForHTTP("/solr/admin/info/system").WithPort(httpPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if len(settings.Cores) > 0 && len(settings.Collections) > 0 {
+		return nil, errors.New("cores and collections cannot be combined, as collections require the SolrCloud mode")
+	}
+
+	var commands [][]string
+	for _, core := range settings.Cores {
+		commands = append(commands, []string{"solr", "create_core", "-c", core})
+	}
+
+	if len(settings.Collections) > 0 {
+		genericContainerReq.Cmd = []string{"solr", "-c", "-f"}
+
+		for _, collection := range settings.Collections {
+			commands = append(commands, []string{"solr", "create_collection", "-c", collection})
+		}
+	}
+
+	if len(commands) > 0 {
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					for _, cmd := range commands {
+						code, reader, err := c.Exec(ctx, cmd, exec.Multiplexed())
+						if err != nil {
+							return fmt.Errorf("failed to create %s: %w", cmd[3], err)
+						}
+
+						if code != 0 {
+							out, _ := io.ReadAll(reader)
+							return fmt.Errorf("failed to create %s, exit code %d: %s", cmd[3], code, string(out))
+						}
+					}
+
+					return nil
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SolrContainer{Container: container}, nil
+}
+
+// URL returns the base URL of Solr, e.g. "http://localhost:32768/solr", to be used by the Solr clients.
+func (c *SolrContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s/solr", host, port.Port()), nil
+}
+
+// UpdateSchema sends the given commands to the Schema API of the core or collection with the given name,
+// e.g. `{"add-field": {"name": "title", "type": "text_general", "stored": true}}`, which are applied in order.
+// It returns an error if any of the commands fails.
+func (c *SolrContainer) UpdateSchema(ctx context.Context, name string, commands string) error {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/"+url.PathEscape(name)+"/schema", bytes.NewBufferString(commands))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Errors json.RawMessage `json:"errors"`
+		Error  *struct {
+			Msg string `json:"msg"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("failed to decode the response: %w", err)
+	}
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update the schema of %s: %s", name, result.Error.Msg)
+	}
+
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("failed to update the schema of %s: %s", name, string(result.Errors))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package solr_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/solr"
+)
+
+func TestSolr(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  solr.Option
+	}{
+		{name: "core", opt: solr.WithCore("books")},
+		{name: "collection", opt: solr.WithCollection("books")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			container, err := solr.RunContainer(ctx, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Clean up the container after the test is complete
+			t.Cleanup(func() {
+				if err := container.Terminate(ctx); err != nil {
+					t.Fatalf("failed to terminate container: %s", err)
+				}
+			})
+
+			// solrURL {
+			solrURL, err := container.URL(ctx)
+			// }
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// updateSchema {
+			err = container.UpdateSchema(ctx, "books", `{
+				"add-field": {"name": "title", "type": "string", "stored": true, "indexed": true}
+			}`)
+			// }
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := http.Post(solrURL+"/books/update?commit=true", "application/json", bytes.NewBufferString(`[{"id": "1", "title": "Dune"}]`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+
+			resp, err = http.Get(solrURL + "/books/select?q=title:Dune")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var result struct {
+				Response struct {
+					NumFound int `json:"numFound"`
+				} `json:"response"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if result.Response.NumFound != 1 {
+				t.Fatalf("expected 1 document, got %d", result.Response.NumFound)
+			}
+		})
+	}
+}
+
+func TestSolrWithInvalidSchema(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := solr.RunContainer(ctx, solr.WithCore("books"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	err = container.UpdateSchema(ctx, "books", `{"add-field": {"name": "title", "type": "unknown"}}`)
+	if err == nil {
+		t.Fatal("expected an error for an unknown field type")
+	}
+}
@@ -0,0 +1,37 @@
+package sonarqube_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/sonarqube"
+)
+
+func ExampleRunContainer() {
+	// runSonarQubeContainer {
+	ctx := context.Background()
+
+	sonarqubeContainer, err := sonarqube.RunContainer(ctx, testcontainers.WithImage("sonarqube:10.3.0-community"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := sonarqubeContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := sonarqubeContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package sonarqube
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// AdminPassword is the password of the admin account, replacing the default one once SonarQube is up.
+	AdminPassword string
+}
+
+func defaultOptions() options {
+	return options{
+		AdminPassword: DefaultAdminPassword,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the SonarQube container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithAdminPassword replaces the default password of the admin account once SonarQube is up.
+func WithAdminPassword(password string) Option {
+	return func(o *options) {
+		o.AdminPassword = password
+	}
+}
@@ -0,0 +1,201 @@
+package sonarqube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "9000/tcp"
+
+	// tokenName is the name of the token generated for the admin account once SonarQube is up.
+	tokenName = "testcontainers"
+)
+
+const (
+	// AdminUser is the login of the admin account.
+	AdminUser = "admin"
+	// DefaultAdminPassword is the default password of the admin account.
+	DefaultAdminPassword = "admin"
+)
+
+// SonarQubeContainer represents the SonarQube container type used in the module
+type SonarQubeContainer struct {
+	testcontainers.Container
+	adminPassword string
+	token         string
+}
+
+// RunContainer creates an instance of the SonarQube container type, with the embedded H2 database and
+// the bootstrap checks of Elasticsearch disabled, so the host doesn't need to be tuned. The container is
+// ready once SonarQube is operational, and a user token of the admin account is then generated with the
+// web API, to be used by the scanners.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SonarQubeContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "sonarqube:10.3.0-community",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"SONAR_ES_BOOTSTRAP_CHECKS_DISABLE": "true",
+		},
+		WaitingFor: wait.ForHTTP("/api/system/status").
+			WithPort(httpPort).
+			WithResponseMatcher(statusUp).
+			WithStartupTimeout(5 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	var token string
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				var err error
+				token, err = bootstrap(ctx, c, settings.AdminPassword)
+				return err
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SonarQubeContainer{Container: container, adminPassword: settings.AdminPassword, token: token}, nil
+}
+
+// statusUp reports whether the system status is UP.
+func statusUp(body io.Reader) bool {
+	var status struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(body).Decode(&status); err != nil {
+		return false
+	}
+
+	return status.Status == "UP"
+}
+
+// bootstrap replaces the default password of the admin account if needed, and generates its token.
+func bootstrap(ctx context.Context, c testcontainers.Container, adminPassword string) (string, error) {
+	baseURL, err := containerURL(ctx, c)
+	if err != nil {
+		return "", err
+	}
+
+	if adminPassword != DefaultAdminPassword {
+		form := url.Values{
+			"login":            {AdminUser},
+			"previousPassword": {DefaultAdminPassword},
+			"password":         {adminPassword},
+		}
+		if _, err := do(ctx, baseURL, AdminUser, DefaultAdminPassword, "/api/users/change_password", form); err != nil {
+			return "", fmt.Errorf("failed to change the admin password: %w", err)
+		}
+	}
+
+	body, err := do(ctx, baseURL, AdminUser, adminPassword, "/api/user_tokens/generate", url.Values{"name": {tokenName}})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate the token: %w", err)
+	}
+
+	var result struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+
+	return result.Token, nil
+}
+
+// URL returns the base URL of SonarQube, e.g. "http://localhost:32768", to be used as the sonar.host.url
+// property of the scanners.
+func (c *SonarQubeContainer) URL(ctx context.Context) (string, error) {
+	return containerURL(ctx, c)
+}
+
+// AdminPassword returns the password of the admin account.
+func (c *SonarQubeContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// Token returns the user token of the admin account, generated once SonarQube is up, to be used as the
+// sonar.token property of the scanners, or as the login of the basic authentication of the web API.
+func (c *SonarQubeContainer) Token() string {
+	return c.token
+}
+
+// CreateProject creates a project with the given key and name, e.g. to analyse it with a scanner.
+func (c *SonarQubeContainer) CreateProject(ctx context.Context, key string, name string) error {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = do(ctx, baseURL, c.token, "", "/api/projects/create", url.Values{"project": {key}, "name": {name}})
+	return err
+}
+
+func containerURL(ctx context.Context, c testcontainers.Container) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, port.Port())), nil
+}
+
+// do posts the given form to the web API, authenticated with the given credentials, which are a token
+// and an empty password for the token authentication.
+func do(ctx context.Context, baseURL string, username string, password string, path string, form url.Values) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(username, password)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("unexpected status code %d for POST %s: %s", resp.StatusCode, path, body)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,151 @@
+package sonarqube_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/sonarqube"
+)
+
+func TestSonarQube(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := sonarqube.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	baseURL, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("token", func(t *testing.T) {
+		// token {
+		token := container.Token()
+		// }
+		if token == "" {
+			t.Fatal("expected a token")
+		}
+
+		if !validCredentials(t, baseURL, token, "") {
+			t.Fatal("expected the token to be valid")
+		}
+	})
+
+	t.Run("create-project", func(t *testing.T) {
+		// createProject {
+		err := container.CreateProject(ctx, "testcontainers", "Testcontainers")
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var result struct {
+			Components []struct {
+				Key string `json:"key"`
+			} `json:"components"`
+		}
+		getJSON(t, baseURL+"/api/projects/search?projects=testcontainers", container.Token(), &result)
+
+		if len(result.Components) != 1 || result.Components[0].Key != "testcontainers" {
+			t.Fatalf("expected the project, got %+v", result.Components)
+		}
+	})
+}
+
+func TestSonarQubeWithAdminPassword(t *testing.T) {
+	ctx := context.Background()
+
+	// withAdminPassword {
+	container, err := sonarqube.RunContainer(ctx, sonarqube.WithAdminPassword("Testcontainers-1"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	baseURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !validCredentials(t, baseURL, sonarqube.AdminUser, container.AdminPassword()) {
+		t.Fatal("expected the new password to be valid")
+	}
+
+	if validCredentials(t, baseURL, sonarqube.AdminUser, sonarqube.DefaultAdminPassword) {
+		t.Fatal("expected the default password to be invalid")
+	}
+
+	if !validCredentials(t, baseURL, container.Token(), "") {
+		t.Fatal("expected the token to be valid")
+	}
+}
+
+// validCredentials reports whether the given credentials are valid.
+func validCredentials(t *testing.T, baseURL string, username string, password string) bool {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/api/authentication/validate", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(username, password)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Valid bool `json:"valid"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	return result.Valid
+}
+
+// getJSON decodes the JSON response of a GET request authenticated with the given token.
+func getJSON(t *testing.T, url string, token string, v interface{}) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(token, "")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,37 @@
+package spark_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/spark"
+)
+
+func ExampleRunContainer() {
+	// runSparkContainer {
+	ctx := context.Background()
+
+	sparkContainer, err := spark.RunContainer(ctx, testcontainers.WithImage("apache/spark:3.5.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := sparkContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := sparkContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
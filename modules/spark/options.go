@@ -0,0 +1,34 @@
+package spark
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// SparkConnect is a flag to start the Spark Connect server in the master container.
+	SparkConnect bool
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Spark container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithSparkConnect starts the Spark Connect server once the cluster is ready, running as an application
+// of the cluster, and exposes its gRPC port. The Spark Connect package is downloaded from Maven Central
+// when the server starts, so the host must have access to it.
+func WithSparkConnect() Option {
+	return func(o *options) {
+		o.SparkConnect = true
+	}
+}
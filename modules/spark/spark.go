@@ -0,0 +1,223 @@
+package spark
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	masterPort   = "7077/tcp"
+	restPort     = "6066/tcp"
+	webUIPort    = "8080/tcp"
+	connectPort  = "15002/tcp"
+	sparkHome    = "/opt/spark"
+	sparkClass   = sparkHome + "/bin/spark-class"
+	masterAlias  = "spark-master"
+	workerAlias  = "spark-worker"
+	ivyCachePath = "/tmp/.ivy2"
+
+	// InternalMasterURL is the URL of the master for the containers attached to the network of the module,
+	// e.g. the driver of an application submitted from a container.
+	InternalMasterURL = "spark://" + masterAlias + ":7077"
+)
+
+// SparkContainer represents the Spark container type used in the module. The embedded container
+// is the master, and the worker is registered with it in the network created by the module.
+type SparkContainer struct {
+	testcontainers.Container
+	Worker       testcontainers.Container
+	network      *testcontainers.DockerNetwork
+	sparkConnect bool
+}
+
+// RunContainer creates an instance of the Spark container type, running a standalone cluster made of
+// a master and a worker attached to a network created by the module. The options are applied to the master,
+// and the worker uses the same image.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SparkContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apache/spark:3.5.0",
+		ExposedPorts: []string{masterPort, restPort, webUIPort, connectPort},
+		Env: map[string]string{
+			"SPARK_MASTER_OPTS": "-Dspark.master.rest.enabled=true",
+			// the scripts of the Spark Connect server write their logs and pid files in these directories
+			"SPARK_LOG_DIR": "/tmp",
+			"SPARK_PID_DIR": "/tmp",
+		},
+		Cmd:        []string{sparkClass, "org.apache.spark.deploy.master.Master", "--host", masterAlias},
+		WaitingFor: wait.ForLog("I have been elected leader! New state: ALIVE").WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	c := &SparkContainer{network: nw, sparkConnect: settings.SparkConnect}
+
+	network.WithNetwork([]string{masterAlias}, nw)(&genericContainerReq)
+
+	master, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+	c.Container = master
+
+	worker, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:    genericContainerReq.Image,
+			Cmd:      []string{sparkClass, "org.apache.spark.deploy.worker.Worker", "--host", workerAlias, InternalMasterURL},
+			Networks: []string{nw.Name},
+			NetworkAliases: map[string][]string{
+				nw.Name: {workerAlias},
+			},
+			WaitingFor: wait.ForLog("Successfully registered with master").WithStartupTimeout(2 * time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start the worker: %w", err)
+	}
+	c.Worker = worker
+
+	if settings.SparkConnect {
+		if err := c.startSparkConnect(ctx); err != nil {
+			_ = c.Terminate(ctx)
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// startSparkConnect starts the Spark Connect server in the master container, as an application of the cluster,
+// and waits for its gRPC port to be listening. The Spark Connect package matching the version of Spark
+// in the image is downloaded from Maven Central.
+func (c *SparkContainer) startSparkConnect(ctx context.Context) error {
+	script := `version=$(ls ` + sparkHome + `/jars | sed -n 's/^spark-core_\(.*\)-\(.*\)\.jar$/\1:\2/p') && ` +
+		sparkHome + `/sbin/start-connect-server.sh --master ` + InternalMasterURL +
+		` --conf spark.jars.ivy=` + ivyCachePath +
+		` --packages org.apache.spark:spark-connect_${version}`
+
+	code, _, err := c.Exec(ctx, []string{"/bin/bash", "-c", script})
+	if err != nil {
+		return fmt.Errorf("failed to start the Spark Connect server: %w", err)
+	}
+	if code != 0 {
+		return fmt.Errorf("failed to start the Spark Connect server: exit code %d", code)
+	}
+
+	err = wait.ForListeningPort(connectPort).WithStartupTimeout(3*time.Minute).WaitUntilReady(ctx, c.Container)
+	if err != nil {
+		return fmt.Errorf("the Spark Connect server is not ready: %w", err)
+	}
+
+	return nil
+}
+
+// MasterURL returns the URL of the master, e.g. "spark://localhost:32768", for the clients running in the host.
+// The drivers of the applications must be reachable from the worker, so the applications are better submitted
+// from a container attached to the network, using InternalMasterURL, or through the REST submission server.
+func (c *SparkContainer) MasterURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, masterPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "spark://" + address, nil
+}
+
+// RESTURL returns the URL of the REST submission server of the master, e.g. "http://localhost:32768",
+// to submit applications with POST /v1/submissions/create, and track them with GET /v1/submissions/status/{id}.
+func (c *SparkContainer) RESTURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, restPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// WebUIURL returns the URL of the web UI of the master, e.g. "http://localhost:32768",
+// whose "/json" endpoint describes the state of the cluster.
+func (c *SparkContainer) WebUIURL(ctx context.Context) (string, error) {
+	address, err := c.address(ctx, webUIPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "http://" + address, nil
+}
+
+// SparkConnectURL returns the URL of the Spark Connect server, e.g. "sc://localhost:32768",
+// to be used as the remote of the spark-connect clients. It returns an error if the server
+// is not enabled with the WithSparkConnect option.
+func (c *SparkContainer) SparkConnectURL(ctx context.Context) (string, error) {
+	if !c.sparkConnect {
+		return "", fmt.Errorf("the Spark Connect server is not enabled")
+	}
+
+	address, err := c.address(ctx, connectPort)
+	if err != nil {
+		return "", err
+	}
+
+	return "sc://" + address, nil
+}
+
+// Terminate terminates the worker and the master containers, and removes the network.
+func (c *SparkContainer) Terminate(ctx context.Context) error {
+	if c.Worker != nil {
+		if err := c.Worker.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Container != nil {
+		if err := c.Container.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.network != nil {
+		return c.network.Remove(ctx)
+	}
+
+	return nil
+}
+
+func (c *SparkContainer) address(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, mappedPort.Port()), nil
+}
@@ -0,0 +1,110 @@
+package spark_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/spark"
+)
+
+func TestSpark(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := spark.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// masterURL {
+	masterURL, err := container.MasterURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(masterURL, "spark://") {
+		t.Fatalf("expected a spark:// URL, got %s", masterURL)
+	}
+
+	webUIURL, err := container.WebUIURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(webUIURL + "/json/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var state struct {
+		AliveWorkers int    `json:"aliveworkers"`
+		Status       string `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		t.Fatal(err)
+	}
+
+	if state.Status != "ALIVE" {
+		t.Fatalf("expected the master to be ALIVE, got %s", state.Status)
+	}
+
+	if state.AliveWorkers != 1 {
+		t.Fatalf("expected 1 alive worker, got %d", state.AliveWorkers)
+	}
+
+	if _, err := container.SparkConnectURL(ctx); err == nil {
+		t.Fatal("expected an error, as the Spark Connect server is not enabled")
+	}
+}
+
+func TestSparkWithSparkConnect(t *testing.T) {
+	ctx := context.Background()
+
+	// withSparkConnect {
+	container, err := spark.RunContainer(ctx, spark.WithSparkConnect())
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// sparkConnectURL {
+	connectURL, err := container.SparkConnectURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(connectURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Scheme != "sc" {
+		t.Fatalf("expected a sc:// URL, got %s", connectURL)
+	}
+
+	conn, err := net.Dial("tcp", u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,37 @@
+package superset_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/superset"
+)
+
+func ExampleRunContainer() {
+	// runSupersetContainer {
+	ctx := context.Background()
+
+	supersetContainer, err := superset.RunContainer(ctx, testcontainers.WithImage("apache/superset:3.1.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := supersetContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := supersetContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,191 @@
+package superset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "8088/tcp"
+
+const (
+	// DefaultAdminUser is the default user of the admin account.
+	DefaultAdminUser = "admin"
+	// DefaultAdminPassword is the default password of the admin account.
+	DefaultAdminPassword = "admin"
+)
+
+// SupersetContainer represents the Superset container type used in the module
+type SupersetContainer struct {
+	testcontainers.Container
+	adminUser     string
+	adminPassword string
+}
+
+// RunContainer creates an instance of the Superset container type, with the SQLite metadata database.
+// Once the webserver is started, the database is upgraded, the admin account is created and the default
+// roles and permissions are initialised, so the container is ready to use like a fresh installation.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*SupersetContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apache/superset:3.1.0",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"SUPERSET_SECRET_KEY": "testcontainers-superset-secret-key",
+		},
+		WaitingFor: wait.ForHTTP("/health").WithPort(httpPort).WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+		PostStarts: []testcontainers.ContainerHook{
+			func(ctx context.Context, c testcontainers.Container) error {
+				return bootstrap(ctx, c, settings.AdminUser, settings.AdminPassword)
+			},
+		},
+	})
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SupersetContainer{
+		Container:     container,
+		adminUser:     settings.AdminUser,
+		adminPassword: settings.AdminPassword,
+	}, nil
+}
+
+// bootstrap upgrades the metadata database, creates the admin account and initialises the default roles
+// and permissions with the Superset CLI.
+func bootstrap(ctx context.Context, c testcontainers.Container, user string, password string) error {
+	cmds := [][]string{
+		{"superset", "db", "upgrade"},
+		{
+			"superset", "fab", "create-admin",
+			"--username", user,
+			"--password", password,
+			"--firstname", "Superset",
+			"--lastname", "Admin",
+			"--email", user + "@example.com",
+		},
+		{"superset", "init"},
+	}
+
+	for _, cmd := range cmds {
+		code, output, err := c.Exec(ctx, cmd, tcexec.Multiplexed())
+		if err != nil {
+			return fmt.Errorf("failed to run %q: %w", cmd[:3], err)
+		}
+
+		if code != 0 {
+			out, _ := io.ReadAll(output)
+			return fmt.Errorf("failed to run %q, exit code %d: %s", cmd[:3], code, string(out))
+		}
+	}
+
+	return nil
+}
+
+// AdminUser returns the user of the admin account.
+func (c *SupersetContainer) AdminUser() string {
+	return c.adminUser
+}
+
+// AdminPassword returns the password of the admin account.
+func (c *SupersetContainer) AdminPassword() string {
+	return c.adminPassword
+}
+
+// URL returns the base URL of Superset, e.g. "http://localhost:32768".
+func (c *SupersetContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// APIURL returns the base URL of the REST API, e.g. "http://localhost:32768/api/v1".
+func (c *SupersetContainer) APIURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v1", nil
+}
+
+// AccessToken authenticates the admin account with the database provider, returning a token
+// to be sent as a bearer token in the Authorization header of the requests to the REST API.
+func (c *SupersetContainer) AccessToken(ctx context.Context) (string, error) {
+	apiURL, err := c.APIURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"username": c.adminUser,
+		"password": c.adminPassword,
+		"provider": "db",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/security/login", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d for POST /api/v1/security/login: %s", resp.StatusCode, respBody)
+	}
+
+	var login struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(respBody, &login); err != nil {
+		return "", err
+	}
+
+	return login.AccessToken, nil
+}
@@ -0,0 +1,69 @@
+package superset_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/superset"
+)
+
+func TestSuperset(t *testing.T) {
+	ctx := context.Background()
+
+	// withAdminCredentials {
+	container, err := superset.RunContainer(ctx, superset.WithAdminCredentials("root", "s3cr3t"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// apiURL {
+	apiURL, err := container.APIURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// accessToken {
+	token, err := container.AccessToken(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, apiURL+"/dashboard/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for the dashboards", resp.StatusCode)
+	}
+
+	var dashboards struct {
+		Count int `json:"count"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&dashboards); err != nil {
+		t.Fatal(err)
+	}
+
+	if dashboards.Count != 0 {
+		t.Fatalf("expected no dashboards in a fresh installation, got %d", dashboards.Count)
+	}
+}
@@ -0,0 +1,37 @@
+package temporal_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/temporal"
+)
+
+func ExampleRunContainer() {
+	// runTemporalContainer {
+	ctx := context.Background()
+
+	temporalContainer, err := temporal.RunContainer(ctx, testcontainers.WithImage("temporalio/temporal:1.1.2"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := temporalContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := temporalContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,47 @@
+package temporal
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Namespaces are the namespaces created when the dev server starts, in addition to the default one.
+	Namespaces []string
+	// DynamicConfig is a map of dynamic config keys and their values, passed to the dev server.
+	DynamicConfig map[string]string
+}
+
+func defaultOptions() options {
+	return options{
+		Namespaces:    []string{},
+		DynamicConfig: make(map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Temporal container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithNamespaces creates the given namespaces when the dev server starts,
+// in addition to the "default" namespace, which is always created.
+func WithNamespaces(namespaces ...string) Option {
+	return func(o *options) {
+		o.Namespaces = append(o.Namespaces, namespaces...)
+	}
+}
+
+// WithDynamicConfigValue sets a dynamic config value of the dev server, e.g.
+// "frontend.enableUpdateWorkflowExecution" to "true". The value must be JSON encoded,
+// so strings must be quoted.
+func WithDynamicConfigValue(key string, value string) Option {
+	return func(o *options) {
+		o.DynamicConfig[key] = value
+	}
+}
@@ -0,0 +1,120 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	// DefaultNamespace is the namespace that is always created by the dev server.
+	DefaultNamespace = "default"
+
+	grpcPort = "7233/tcp"
+	uiPort   = "8233/tcp"
+)
+
+// TemporalContainer represents the Temporal container type used in the module
+type TemporalContainer struct {
+	testcontainers.Container
+	namespaces []string
+}
+
+// RunContainer creates an instance of the Temporal container type, running the Temporal dev server
+// with an in-memory database, the gRPC frontend and the Web UI.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*TemporalContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "temporalio/temporal:1.1.2",
+		ExposedPorts: []string{grpcPort, uiPort},
+		WaitingFor: wait.ForAll(
+			wait.ForListeningPort(grpcPort),
+			wait.ForHTTP("/").WithPort(uiPort),
+		),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	genericContainerReq.Cmd = append(command(settings), genericContainerReq.Cmd...)
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := append([]string{DefaultNamespace}, settings.Namespaces...)
+
+	return &TemporalContainer{Container: container, namespaces: namespaces}, nil
+}
+
+// command returns the arguments of the "temporal" entrypoint to start the dev server
+// listening on all the interfaces.
+func command(settings options) []string {
+	cmd := []string{"server", "start-dev", "--ip", "0.0.0.0"}
+
+	for _, ns := range settings.Namespaces {
+		cmd = append(cmd, "--namespace", ns)
+	}
+
+	keys := make([]string, 0, len(settings.DynamicConfig))
+	for key := range settings.DynamicConfig {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		cmd = append(cmd, "--dynamic-config-value", key+"="+settings.DynamicConfig[key])
+	}
+
+	return cmd
+}
+
+// GRPCHostPort returns the "host:port" address of the gRPC frontend service, to be used as
+// the HostPort of the Temporal SDK clients and workers.
+func (c *TemporalContainer) GRPCHostPort(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, grpcPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// UIURL returns the URL of the Temporal Web UI, e.g. "http://localhost:32768".
+func (c *TemporalContainer) UIURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, uiPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, port.Port())), nil
+}
+
+// Namespaces returns the namespaces created when the dev server started, including the default one.
+func (c *TemporalContainer) Namespaces() []string {
+	return c.namespaces
+}
@@ -0,0 +1,93 @@
+package temporal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestTemporal(t *testing.T) {
+	ctx := context.Background()
+
+	// withNamespaces {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("temporalio/temporal:1.1.2"),
+		WithNamespaces("orders", "payments"),
+	)
+	// }
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	assert.Equal(t, []string{DefaultNamespace, "orders", "payments"}, container.Namespaces())
+
+	t.Run("grpc", func(t *testing.T) {
+		// grpcHostPort {
+		hostPort, err := container.GRPCHostPort(ctx)
+		// }
+		require.NoError(t, err)
+
+		conn, err := grpc.DialContext(ctx, hostPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+			Service: "temporal.api.workflowservice.v1.WorkflowService",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("ui", func(t *testing.T) {
+		uiURL, err := container.UIURL(ctx)
+		require.NoError(t, err)
+
+		// the UI server exposes the namespaces of the dev server
+		resp, err := http.Get(uiURL + "/api/v1/namespaces")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Namespaces []struct {
+				NamespaceInfo struct {
+					Name string `json:"name"`
+				} `json:"namespaceInfo"`
+			} `json:"namespaces"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+		names := make([]string, 0, len(body.Namespaces))
+		for _, ns := range body.Namespaces {
+			names = append(names, ns.NamespaceInfo.Name)
+		}
+		assert.Subset(t, names, container.Namespaces())
+	})
+}
+
+func TestCommand(t *testing.T) {
+	settings := defaultOptions()
+	WithNamespaces("orders")(&settings)
+	WithDynamicConfigValue("limit.maxIDLength", "255")(&settings)
+	WithDynamicConfigValue("frontend.enableUpdateWorkflowExecution", "true")(&settings)
+
+	assert.Equal(t, []string{
+		"server", "start-dev", "--ip", "0.0.0.0",
+		"--namespace", "orders",
+		"--dynamic-config-value", "frontend.enableUpdateWorkflowExecution=true",
+		"--dynamic-config-value", "limit.maxIDLength=255",
+	}, command(settings))
+}
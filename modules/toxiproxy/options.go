@@ -0,0 +1,44 @@
+package toxiproxy
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	proxies []proxyDefinition
+}
+
+// proxyDefinition represents a proxy to be created right after the container starts.
+type proxyDefinition struct {
+	name       string
+	upstream   string
+	listenPort int
+}
+
+func defaultOptions() options {
+	return options{
+		proxies: []proxyDefinition{},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Toxiproxy container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithProxy registers a proxy to be created right after the container starts.
+// The upstream is the address of the proxied service, in the format "host:port",
+// as seen from the Toxiproxy container, e.g. the network alias of another container
+// attached to the same Docker network. Each proxy listens on its own port,
+// which is exposed and mapped to the host.
+func WithProxy(name string, upstream string) Option {
+	return func(o *options) {
+		o.proxies = append(o.proxies, proxyDefinition{name: name, upstream: upstream})
+	}
+}
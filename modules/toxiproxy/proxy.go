@@ -0,0 +1,53 @@
+package toxiproxy
+
+import (
+	"time"
+
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
+	"github.com/docker/go-connections/nat"
+)
+
+// Stream represents the direction of the connection a toxic is applied to.
+type Stream string
+
+const (
+	// Upstream applies the toxic to the data sent from the client to the upstream.
+	Upstream Stream = "upstream"
+	// Downstream applies the toxic to the data sent from the upstream to the client.
+	Downstream Stream = "downstream"
+)
+
+// Proxy represents a proxy created in the Toxiproxy container, wrapping the
+// proxy from the Toxiproxy client with typed helpers to add the most common toxics.
+type Proxy struct {
+	*toxiproxy.Proxy
+	port nat.Port
+}
+
+// AddLatency adds a delay to all data going through the proxy in the given direction.
+// The jitter is added to or subtracted from the latency at random.
+func (p *Proxy) AddLatency(name string, stream Stream, latency time.Duration, jitter time.Duration) error {
+	_, err := p.AddToxic(name, "latency", string(stream), 1.0, toxiproxy.Attributes{
+		"latency": latency.Milliseconds(),
+		"jitter":  jitter.Milliseconds(),
+	})
+	return err
+}
+
+// AddBandwidth limits the connection in the given direction to a maximum number of kilobytes per second.
+func (p *Proxy) AddBandwidth(name string, stream Stream, rateKB int64) error {
+	_, err := p.AddToxic(name, "bandwidth", string(stream), 1.0, toxiproxy.Attributes{
+		"rate": rateKB,
+	})
+	return err
+}
+
+// AddTimeout stops all data from getting through the proxy in the given direction,
+// closing the connection after the timeout. If the timeout is zero, the connection
+// won't be closed, and the data will be delayed until the toxic is removed.
+func (p *Proxy) AddTimeout(name string, stream Stream, timeout time.Duration) error {
+	_, err := p.AddToxic(name, "timeout", string(stream), 1.0, toxiproxy.Attributes{
+		"timeout": timeout.Milliseconds(),
+	})
+	return err
+}
@@ -0,0 +1,122 @@
+package toxiproxy
+
+import (
+	"context"
+	"fmt"
+
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	defaultControlPort = "8474/tcp"
+	// firstProxyPort is the port used by the first proxy registered with WithProxy.
+	// Subsequent proxies will use consecutive ports.
+	firstProxyPort = 8666
+)
+
+// ToxiproxyContainer represents the Toxiproxy container type used in the module
+type ToxiproxyContainer struct {
+	testcontainers.Container
+	client  *toxiproxy.Client
+	proxies map[string]*Proxy
+}
+
+// RunContainer creates an instance of the Toxiproxy container type
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*ToxiproxyContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "ghcr.io/shopify/toxiproxy:2.7.0",
+		ExposedPorts: []string{defaultControlPort},
+		WaitingFor:   wait.ForHTTP("/version").WithPort(defaultControlPort),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	// expose one port per proxy, so that they can be reached from the host
+	for i := range settings.proxies {
+		settings.proxies[i].listenPort = firstProxyPort + i
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, fmt.Sprintf("%d/tcp", settings.proxies[i].listenPort))
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ToxiproxyContainer{Container: container, proxies: map[string]*Proxy{}}
+
+	uri, err := c.URI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.client = toxiproxy.NewClient(uri)
+
+	for _, p := range settings.proxies {
+		proxy, err := c.client.CreateProxy(p.name, fmt.Sprintf("0.0.0.0:%d", p.listenPort), p.upstream)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create proxy %q: %w", p.name, err)
+		}
+
+		c.proxies[p.name] = &Proxy{Proxy: proxy, port: nat.Port(fmt.Sprintf("%d/tcp", p.listenPort))}
+	}
+
+	return c, nil
+}
+
+// URI returns the address of the Toxiproxy control API, in the format "host:port",
+// which can be used to create a toxiproxy client.
+func (c *ToxiproxyContainer) URI(ctx context.Context) (string, error) {
+	return c.PortEndpoint(ctx, defaultControlPort, "")
+}
+
+// Client returns a client for the Toxiproxy control API, which can be used to
+// manage proxies that were not registered with the WithProxy option.
+func (c *ToxiproxyContainer) Client() *toxiproxy.Client {
+	return c.client
+}
+
+// Proxy returns the proxy registered with the given name using the WithProxy option.
+func (c *ToxiproxyContainer) Proxy(name string) (*Proxy, error) {
+	proxy, ok := c.proxies[name]
+	if !ok {
+		return nil, fmt.Errorf("proxy %q not found", name)
+	}
+
+	return proxy, nil
+}
+
+// ProxiedEndpoint returns the host and the mapped port of the proxy registered with
+// the given name, so that clients in the host can reach the upstream through it.
+func (c *ToxiproxyContainer) ProxiedEndpoint(ctx context.Context, name string) (string, string, error) {
+	proxy, err := c.Proxy(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	port, err := c.MappedPort(ctx, proxy.port)
+	if err != nil {
+		return "", "", err
+	}
+
+	return host, port.Port(), nil
+}
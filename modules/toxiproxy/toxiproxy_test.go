@@ -0,0 +1,118 @@
+package toxiproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestToxiproxy(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx)
+	require.NoError(t, err)
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// controlURI {
+	uri, err := container.URI(ctx)
+	// }
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/version", uri))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = container.Proxy("unknown")
+	require.Error(t, err)
+}
+
+func TestToxiproxy_withProxy(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	nginxC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:      "nginx:alpine",
+			WaitingFor: wait.ForListeningPort("80/tcp"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nginxC.Terminate(ctx))
+	})
+
+	require.NoError(t, nw.Connect(ctx, nginxC, "nginx"))
+
+	// withProxy {
+	container, err := RunContainer(ctx,
+		network.WithNetwork([]string{"toxiproxy"}, nw),
+		WithProxy("nginx", "nginx:80"),
+	)
+	// }
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// proxiedEndpoint {
+	host, port, err := container.ProxiedEndpoint(ctx, "nginx")
+	// }
+	require.NoError(t, err)
+
+	url := fmt.Sprintf("http://%s:%s", host, port)
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// addLatency {
+	proxy, err := container.Proxy("nginx")
+	require.NoError(t, err)
+
+	err = proxy.AddLatency("latency_down", Downstream, 1*time.Second, 0)
+	// }
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err = http.Get(url)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.GreaterOrEqual(t, time.Since(start), 1*time.Second)
+
+	require.NoError(t, proxy.RemoveToxic("latency_down"))
+
+	// addTimeout {
+	err = proxy.AddTimeout("timeout_down", Downstream, 100*time.Millisecond)
+	// }
+	require.NoError(t, err)
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	_, err = httpClient.Get(url)
+	require.Error(t, err)
+}
@@ -0,0 +1,37 @@
+package traefik_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/traefik"
+)
+
+func ExampleRunContainer() {
+	// runTraefikContainer {
+	ctx := context.Background()
+
+	traefikContainer, err := traefik.RunContainer(ctx, testcontainers.WithImage("traefik:v2.11.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := traefikContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := traefikContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
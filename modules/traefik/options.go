@@ -0,0 +1,56 @@
+package traefik
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Entrypoints are the ports of the entrypoints in the container, by name.
+	Entrypoints map[string]int
+	// DockerProvider enables the Docker provider, which reads the routes from the labels of the containers.
+	DockerProvider bool
+	// DynamicConfig is the content of the dynamic configuration file read by the file provider.
+	DynamicConfig string
+}
+
+func defaultOptions() options {
+	return options{
+		Entrypoints: map[string]int{WebEntrypoint: 80},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Traefik container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithEntrypoint adds an HTTP entrypoint listening on the given port of the container, whose URL is returned
+// by the EntrypointURL method. The routers listen on all the entrypoints, unless their entrypoints are set.
+func WithEntrypoint(name string, port int) Option {
+	return func(o *options) {
+		o.Entrypoints[name] = port
+	}
+}
+
+// WithDockerProvider enables the Docker provider, mounting the Docker socket in the container. It routes the
+// requests to the containers with the traefik.enable=true label, e.g. the labels returned by RouteLabels,
+// through the first network of Traefik, which must be shared with the routed containers.
+func WithDockerProvider() Option {
+	return func(o *options) {
+		o.DockerProvider = true
+	}
+}
+
+// WithDynamicConfig enables the file provider with the given dynamic configuration, in YAML, e.g. routing
+// the requests to other containers of the same network, using their network aliases.
+func WithDynamicConfig(config string) Option {
+	return func(o *options) {
+		o.DynamicConfig = config
+	}
+}
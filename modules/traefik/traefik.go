@@ -0,0 +1,151 @@
+package traefik
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	// WebEntrypoint is the name of the default entrypoint, listening on the port 80 of the container.
+	WebEntrypoint = "web"
+
+	apiPort = "8080/tcp"
+
+	dynamicConfigPath = "/etc/traefik/dynamic.yml"
+)
+
+// TraefikContainer represents the Traefik container type used in the module
+type TraefikContainer struct {
+	testcontainers.Container
+	entrypoints map[string]nat.Port
+}
+
+// RunContainer creates an instance of the Traefik container type, with the web entrypoint, the entrypoints
+// set with the WithEntrypoint option, and the API and dashboard. The routes are configured with the Docker
+// provider, reading the labels of the other containers, or with the file provider.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*TraefikContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "traefik:v2.11.0",
+		ExposedPorts: []string{apiPort},
+		Cmd:          []string{"--api.insecure=true", "--ping=true", "--log.level=INFO"},
+		WaitingFor:   wait.ForHTTP("/ping").WithPort(apiPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	entrypoints := make(map[string]nat.Port, len(settings.Entrypoints))
+	names := make([]string, 0, len(settings.Entrypoints))
+	for name := range settings.Entrypoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		port := settings.Entrypoints[name]
+		entrypoints[name] = nat.Port(strconv.Itoa(port) + "/tcp")
+		genericContainerReq.ExposedPorts = append(genericContainerReq.ExposedPorts, string(entrypoints[name]))
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, fmt.Sprintf("--entrypoints.%s.address=:%d", name, port))
+	}
+
+	if settings.DockerProvider {
+		dockerHost := testcontainers.ExtractDockerSocket()
+		hostConfigModifier := genericContainerReq.HostConfigModifier
+		genericContainerReq.HostConfigModifier = func(hostConfig *container.HostConfig) {
+			if hostConfigModifier != nil {
+				hostConfigModifier(hostConfig)
+			}
+			hostConfig.Binds = append(hostConfig.Binds, dockerHost+":/var/run/docker.sock:ro")
+		}
+
+		// only the containers with the traefik.enable=true label are routed
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--providers.docker=true", "--providers.docker.exposedbydefault=false")
+
+		// the routed containers are reached through the network shared with Traefik
+		if len(genericContainerReq.Networks) > 0 {
+			genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--providers.docker.network="+genericContainerReq.Networks[0])
+		}
+	}
+
+	if settings.DynamicConfig != "" {
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "--providers.file.filename="+dynamicConfigPath)
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					return c.CopyToContainer(ctx, []byte(settings.DynamicConfig), dynamicConfigPath, 0o644)
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TraefikContainer{Container: container, entrypoints: entrypoints}, nil
+}
+
+// RouteLabels returns the labels routing the HTTP requests matching the given rule, e.g. "Host(`app.localhost`)",
+// to the given port of the labeled container, when the Docker provider is enabled with the WithDockerProvider option.
+func RouteLabels(router string, rule string, port int) map[string]string {
+	return map[string]string{
+		"traefik.enable": "true",
+		"traefik.http.routers." + router + ".rule":                      rule,
+		"traefik.http.services." + router + ".loadbalancer.server.port": strconv.Itoa(port),
+	}
+}
+
+// URL returns the base URL of the web entrypoint, e.g. "http://localhost:32768".
+func (c *TraefikContainer) URL(ctx context.Context) (string, error) {
+	return c.EntrypointURL(ctx, WebEntrypoint)
+}
+
+// EntrypointURL returns the base URL of the given entrypoint, e.g. "http://localhost:32768".
+func (c *TraefikContainer) EntrypointURL(ctx context.Context, name string) (string, error) {
+	port, ok := c.entrypoints[name]
+	if !ok {
+		return "", fmt.Errorf("unknown entrypoint %s", name)
+	}
+
+	return c.url(ctx, port)
+}
+
+// DashboardURL returns the base URL of the API and the dashboard, e.g. "http://localhost:32768",
+// serving the routers under /api/http/routers and the dashboard under /dashboard/.
+func (c *TraefikContainer) DashboardURL(ctx context.Context) (string, error) {
+	return c.url(ctx, apiPort)
+}
+
+func (c *TraefikContainer) url(ctx context.Context, port nat.Port) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, mappedPort.Port()), nil
+}
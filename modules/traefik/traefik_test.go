@@ -0,0 +1,197 @@
+package traefik_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/traefik"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestTraefikWithDockerProvider(t *testing.T) {
+	ctx := context.Background()
+
+	nw := newNetwork(t)
+
+	// routeLabels {
+	whoami, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:          "traefik/whoami:v1.10.1",
+			Networks:       []string{nw.Name},
+			NetworkAliases: map[string][]string{nw.Name: {"whoami"}},
+			Labels:         traefik.RouteLabels("whoami", "Host(`whoami.localhost`)", 80),
+			WaitingFor:     wait.ForListeningPort("80/tcp"),
+		},
+		Started: true,
+	})
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := whoami.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// withDockerProvider {
+	container, err := traefik.RunContainer(ctx, network.WithNetwork([]string{"traefik"}, nw), traefik.WithDockerProvider())
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// url {
+	url, err := container.URL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := waitForRoute(t, url, "whoami.localhost")
+	if !strings.Contains(body, "Hostname:") {
+		t.Fatalf("expected the response of whoami, got %s", body)
+	}
+
+	dashboardURL, err := container.DashboardURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body = waitForRoute(t, dashboardURL+"/api/http/routers", "")
+	if !strings.Contains(body, "whoami@docker") {
+		t.Fatalf("expected the whoami router, got %s", body)
+	}
+}
+
+func TestTraefikWithDynamicConfig(t *testing.T) {
+	ctx := context.Background()
+
+	nw := newNetwork(t)
+
+	whoami, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:          "traefik/whoami:v1.10.1",
+			Networks:       []string{nw.Name},
+			NetworkAliases: map[string][]string{nw.Name: {"whoami"}},
+			WaitingFor:     wait.ForListeningPort("80/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := whoami.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// withDynamicConfig {
+	container, err := traefik.RunContainer(ctx,
+		network.WithNetwork([]string{"traefik"}, nw),
+		traefik.WithEntrypoint("api", 8000),
+		traefik.WithDynamicConfig(`
+http:
+  routers:
+    whoami:
+      entryPoints: [api]
+      rule: PathPrefix(`+"`/`"+`)
+      service: whoami
+  services:
+    whoami:
+      loadBalancer:
+        servers:
+          - url: http://whoami:80
+`),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// entrypointURL {
+	url, err := container.EntrypointURL(ctx, "api")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := waitForRoute(t, url+"/orders", "")
+	if !strings.Contains(body, "GET /orders") {
+		t.Fatalf("expected the request to be routed to whoami, got %s", body)
+	}
+
+	if _, err := container.EntrypointURL(ctx, "unknown"); err == nil {
+		t.Fatal("expected an error for an unknown entrypoint")
+	}
+}
+
+func newNetwork(t *testing.T) *testcontainers.DockerNetwork {
+	t.Helper()
+
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := nw.Remove(ctx); err != nil {
+			t.Fatalf("failed to remove network: %s", err)
+		}
+	})
+
+	return nw
+}
+
+// waitForRoute retries the request until it succeeds, as the providers load the routes asynchronously.
+func waitForRoute(t *testing.T, url string, host string) string {
+	t.Helper()
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if host != "" {
+			req.Host = host
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if readErr == nil && resp.StatusCode == http.StatusOK {
+				return string(body)
+			}
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("the route of %s was not ready", url)
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+}
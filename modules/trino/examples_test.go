@@ -0,0 +1,37 @@
+package trino_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/trino"
+)
+
+func ExampleRunContainer() {
+	// runTrinoContainer {
+	ctx := context.Background()
+
+	trinoContainer, err := trino.RunContainer(ctx, testcontainers.WithImage("trinodb/trino:436"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := trinoContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := trinoContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
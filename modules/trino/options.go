@@ -0,0 +1,47 @@
+package trino
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Catalogs is a map of catalog names (key) and their properties (value),
+	// rendered into the catalog property files of the coordinator.
+	Catalogs map[string]map[string]string
+	// CatalogFiles is a list of catalog property files in the host, named "<catalog>.properties".
+	CatalogFiles []string
+}
+
+func defaultOptions() options {
+	return options{
+		Catalogs: make(map[string]map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Trino container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithCatalog adds a catalog with the given name and properties, which must include the "connector.name" property,
+// e.g. WithCatalog("memory", map[string]string{"connector.name": "memory"}). It can be called multiple times,
+// and it replaces the catalogs of the image with the same name.
+func WithCatalog(name string, properties map[string]string) Option {
+	return func(o *options) {
+		o.Catalogs[name] = properties
+	}
+}
+
+// WithCatalogFile adds a catalog from a property file in the host, whose name without
+// the ".properties" extension is the name of the catalog. It can be called multiple times.
+func WithCatalogFile(path string) Option {
+	return func(o *options) {
+		o.CatalogFiles = append(o.CatalogFiles, path)
+	}
+}
@@ -0,0 +1,160 @@
+package trino
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "8080/tcp"
+
+	catalogPath = "/etc/trino/catalog"
+
+	// defaultUser is the user of the connection string. Trino doesn't authenticate the users by default.
+	defaultUser = "test"
+)
+
+// TrinoContainer represents the Trino container type used in the module
+type TrinoContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Trino container type, running a single node acting as
+// both the coordinator and the worker.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*TrinoContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "trinodb/trino:436",
+		ExposedPorts: []string{httpPort},
+		WaitingFor: wait.ForHTTP("/v1/info").
+			WithPort(httpPort).
+			WithStartupTimeout(2 * time.Minute).
+			WithResponseMatcher(func(body io.Reader) bool {
+				data, err := io.ReadAll(body)
+				if err != nil {
+					return false
+				}
+
+				return bytes.Contains(data, []byte(`"starting":false`))
+			}),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	for _, path := range settings.CatalogFiles {
+		if filepath.Ext(path) != ".properties" {
+			return nil, fmt.Errorf("the catalog file %s must have the .properties extension", path)
+		}
+
+		genericContainerReq.Files = append(genericContainerReq.Files, testcontainers.ContainerFile{
+			HostFilePath:      path,
+			ContainerFilePath: catalogPath + "/" + filepath.Base(path),
+			FileMode:          0o644,
+		})
+	}
+
+	if len(settings.Catalogs) > 0 {
+		catalogs := make(map[string][]byte, len(settings.Catalogs))
+		for name, properties := range settings.Catalogs {
+			if _, ok := properties["connector.name"]; !ok {
+				return nil, fmt.Errorf("the connector.name property of the catalog %s is required", name)
+			}
+
+			catalogs[name] = renderProperties(properties)
+		}
+
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					for name, content := range catalogs {
+						if err := c.CopyToContainer(ctx, content, catalogPath+"/"+name+".properties", 0o644); err != nil {
+							return fmt.Errorf("copy catalog %s: %w", name, err)
+						}
+					}
+
+					return nil
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrinoContainer{Container: container}, nil
+}
+
+// renderProperties returns the content of a property file, with the properties sorted by key.
+func renderProperties(properties map[string]string) []byte {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		buf.WriteString(k + "=" + properties[k] + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// ConnectionString returns the connection string for the Trino coordinator, in the format of the
+// github.com/trinodb/trino-go-client driver, e.g. "http://test@localhost:32768?catalog=tpch&schema=tiny".
+// The args are appended as query parameters, e.g. "catalog=tpch" and "schema=tiny".
+func (c *TrinoContainer) ConnectionString(ctx context.Context, args ...string) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	connStr := fmt.Sprintf("http://%s@%s:%s", defaultUser, host, port.Port())
+	if len(args) > 0 {
+		connStr += "?" + strings.Join(args, "&")
+	}
+
+	return connStr, nil
+}
+
+// JDBCURL returns the JDBC URL of the Trino coordinator, e.g. "jdbc:trino://localhost:32768",
+// for the tools and the services running on the JVM.
+func (c *TrinoContainer) JDBCURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("jdbc:trino://%s:%s", host, port.Port()), nil
+}
@@ -0,0 +1,108 @@
+package trino_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/trinodb/trino-go-client/trino"
+
+	"github.com/testcontainers/testcontainers-go/modules/trino"
+)
+
+func TestTrino(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := trino.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connStr, err := container.ConnectionString(ctx, "catalog=tpch", "schema=tiny")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("trino", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM nation").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 25 {
+		t.Fatalf("expected 25 nations, got %d", count)
+	}
+}
+
+func TestTrinoWithCatalogs(t *testing.T) {
+	ctx := context.Background()
+
+	// withCatalogs {
+	container, err := trino.RunContainer(ctx,
+		trino.WithCatalog("mem", map[string]string{"connector.name": "memory"}),
+		trino.WithCatalogFile(filepath.Join("testdata", "blackhole.properties")),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("trino", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// a federated query, joining a table of the memory catalog with a table of the tpch catalog
+	for _, query := range []string{
+		"CREATE TABLE mem.default.regions AS SELECT regionkey, name FROM tpch.tiny.region",
+		"CREATE TABLE blackhole.default.sink (id BIGINT)",
+	} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var count int
+	err = db.QueryRowContext(ctx, `SELECT count(*) FROM tpch.tiny.nation n JOIN mem.default.regions r ON n.regionkey = r.regionkey`).Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 25 {
+		t.Fatalf("expected 25 nations, got %d", count)
+	}
+}
+
+func TestTrinoWithInvalidCatalog(t *testing.T) {
+	_, err := trino.RunContainer(context.Background(), trino.WithCatalog("mem", map[string]string{}))
+	if err == nil {
+		t.Fatal("expected an error for a catalog without connector.name")
+	}
+}
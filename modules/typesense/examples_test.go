@@ -0,0 +1,37 @@
+package typesense_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/typesense"
+)
+
+func ExampleRunContainer() {
+	// runTypesenseContainer {
+	ctx := context.Background()
+
+	typesenseContainer, err := typesense.RunContainer(ctx, testcontainers.WithImage("typesense/typesense:0.25.2"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := typesenseContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := typesenseContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
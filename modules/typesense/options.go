@@ -0,0 +1,34 @@
+package typesense
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// APIKey is the admin API key of the server.
+	APIKey string
+}
+
+func defaultOptions() options {
+	return options{
+		APIKey: defaultAPIKey,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Typesense container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithAPIKey sets the admin API key of the server, which is "testcontainers" by default.
+func WithAPIKey(apiKey string) Option {
+	return func(o *options) {
+		o.APIKey = apiKey
+	}
+}
@@ -0,0 +1,95 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "8108/tcp"
+
+	// defaultAPIKey is the admin API key used when WithAPIKey is not set, as Typesense requires one.
+	defaultAPIKey = "testcontainers"
+)
+
+// TypesenseContainer represents the Typesense container type used in the module
+type TypesenseContainer struct {
+	testcontainers.Container
+	apiKey string
+}
+
+// RunContainer creates an instance of the Typesense container type, which is ready once
+// its /health endpoint reports it's ok.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*TypesenseContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "typesense/typesense:0.25.2",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"TYPESENSE_DATA_DIR":    "/data",
+			"TYPESENSE_ENABLE_CORS": "true",
+		},
+		WaitingFor: wait.ForHTTP("/health").
+			WithPort(httpPort).
+			WithStartupTimeout(time.Minute).
+			WithResponseMatcher(func(body io.Reader) bool {
+				var health struct {
+					OK bool `json:"ok"`
+				}
+				if err := json.NewDecoder(body).Decode(&health); err != nil {
+					return false
+				}
+				return health.OK
+			}),
+		// the data directory must exist
+		Tmpfs: map[string]string{"/data": "rw"},
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	genericContainerReq.Env["TYPESENSE_API_KEY"] = settings.APIKey
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypesenseContainer{Container: container, apiKey: settings.APIKey}, nil
+}
+
+// RESTURL returns the URL of the REST API of Typesense, e.g. "http://localhost:32768",
+// to be used as the server of the Typesense Go client.
+func (c *TypesenseContainer) RESTURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// APIKey returns the admin API key, to be sent in the X-TYPESENSE-API-KEY header.
+func (c *TypesenseContainer) APIKey() string {
+	return c.apiKey
+}
@@ -0,0 +1,77 @@
+package typesense_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/typesense"
+)
+
+func TestTypesense(t *testing.T) {
+	ctx := context.Background()
+
+	// withAPIKey {
+	container, err := typesense.RunContainer(ctx, typesense.WithAPIKey("my-api-key"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// restURL {
+	restURL, err := container.RESTURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		apiKey string
+		status int
+	}{
+		{name: "valid API key", apiKey: container.APIKey(), status: http.StatusOK},
+		{name: "invalid API key", apiKey: "invalid", status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, restURL+"/collections", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("X-TYPESENSE-API-KEY", tt.apiKey)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status code %d, got %d", tt.status, resp.StatusCode)
+			}
+
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var collections []interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&collections); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(collections) != 0 {
+				t.Fatalf("expected no collections, got %d", len(collections))
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package unleash_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/unleash"
+)
+
+func ExampleRunContainer() {
+	// runUnleashContainer {
+	ctx := context.Background()
+
+	unleashContainer, err := unleash.RunContainer(ctx, testcontainers.WithImage("unleashorg/unleash-server:5.8.2"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := unleashContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := unleashContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,68 @@
+package unleash
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+const (
+	// DefaultClientAPIToken is the client API token created at startup, for the backend SDKs,
+	// granting access to the development environment of all the projects.
+	DefaultClientAPIToken = "*:development.unleash-insecure-client-api-token"
+	// DefaultFrontendAPIToken is the frontend API token created at startup, for the frontend SDKs,
+	// granting access to the development environment of all the projects.
+	DefaultFrontendAPIToken = "*:development.unleash-insecure-frontend-api-token"
+	// DefaultAdminAPIToken is the admin API token created at startup, for the admin API.
+	DefaultAdminAPIToken = "*:*.unleash-insecure-admin-api-token"
+)
+
+type options struct {
+	// ClientAPIToken is the client API token created at startup.
+	ClientAPIToken string
+	// FrontendAPIToken is the frontend API token created at startup.
+	FrontendAPIToken string
+	// AdminAPIToken is the admin API token created at startup.
+	AdminAPIToken string
+}
+
+func defaultOptions() options {
+	return options{
+		ClientAPIToken:   DefaultClientAPIToken,
+		FrontendAPIToken: DefaultFrontendAPIToken,
+		AdminAPIToken:    DefaultAdminAPIToken,
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Unleash container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithClientAPIToken sets the client API token created at startup, in the "<project>:<environment>.<secret>"
+// format of Unleash, e.g. "default:production.secret". Defaults to the DefaultClientAPIToken.
+func WithClientAPIToken(token string) Option {
+	return func(o *options) {
+		o.ClientAPIToken = token
+	}
+}
+
+// WithFrontendAPIToken sets the frontend API token created at startup, in the "<project>:<environment>.<secret>"
+// format of Unleash. Defaults to the DefaultFrontendAPIToken.
+func WithFrontendAPIToken(token string) Option {
+	return func(o *options) {
+		o.FrontendAPIToken = token
+	}
+}
+
+// WithAdminAPIToken sets the admin API token created at startup, in the "*:*.<secret>" format of Unleash.
+// Defaults to the DefaultAdminAPIToken.
+func WithAdminAPIToken(token string) Option {
+	return func(o *options) {
+		o.AdminAPIToken = token
+	}
+}
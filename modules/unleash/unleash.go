@@ -0,0 +1,259 @@
+package unleash
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "4242/tcp"
+
+	postgresImage    = "postgres:15.5-alpine"
+	postgresAlias    = "postgres"
+	postgresUser     = "unleash"
+	postgresPassword = "unleash"
+	postgresDatabase = "unleash"
+)
+
+// UnleashContainer represents the Unleash container type used in the module. The embedded container
+// is the Unleash server, and the Postgres database it requires is attached to the same network,
+// created by the module.
+type UnleashContainer struct {
+	testcontainers.Container
+	Postgres         testcontainers.Container
+	network          *testcontainers.DockerNetwork
+	clientAPIToken   string
+	frontendAPIToken string
+	adminAPIToken    string
+}
+
+// RunContainer creates an instance of the Unleash container type, running the Unleash server and its
+// Postgres database, attached to a network created by the module. The API tokens set by the options are
+// created at startup, so the SDKs can connect without any setup. The options are applied to the Unleash server.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*UnleashContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "unleashorg/unleash-server:5.8.2",
+		ExposedPorts: []string{httpPort},
+		Env: map[string]string{
+			"DATABASE_HOST":     postgresAlias,
+			"DATABASE_NAME":     postgresDatabase,
+			"DATABASE_USERNAME": postgresUser,
+			"DATABASE_PASSWORD": postgresPassword,
+			"DATABASE_SSL":      "false",
+			"LOG_LEVEL":         "warn",
+		},
+		WaitingFor: wait.ForHTTP("/health").
+			WithPort(httpPort).
+			WithStartupTimeout(2 * time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	genericContainerReq.Env["INIT_CLIENT_API_TOKENS"] = settings.ClientAPIToken
+	genericContainerReq.Env["INIT_FRONTEND_API_TOKENS"] = settings.FrontendAPIToken
+	genericContainerReq.Env["INIT_ADMIN_API_TOKENS"] = settings.AdminAPIToken
+
+	nw, err := network.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	c := &UnleashContainer{
+		network:          nw,
+		clientAPIToken:   settings.ClientAPIToken,
+		frontendAPIToken: settings.FrontendAPIToken,
+		adminAPIToken:    settings.AdminAPIToken,
+	}
+
+	postgres, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image: postgresImage,
+			Env: map[string]string{
+				"POSTGRES_USER":     postgresUser,
+				"POSTGRES_PASSWORD": postgresPassword,
+				"POSTGRES_DB":       postgresDatabase,
+			},
+			Networks: []string{nw.Name},
+			NetworkAliases: map[string][]string{
+				nw.Name: {postgresAlias},
+			},
+			// the database is restarted once initialised
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start postgres: %w", err)
+	}
+	c.Postgres = postgres
+
+	network.WithNetwork([]string{"unleash"}, nw)(&genericContainerReq)
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, err
+	}
+	c.Container = container
+
+	return c, nil
+}
+
+// Terminate terminates the Unleash and the Postgres containers, and removes the network.
+func (c *UnleashContainer) Terminate(ctx context.Context) error {
+	if c.Container != nil {
+		if err := c.Container.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.Postgres != nil {
+		if err := c.Postgres.Terminate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.network != nil {
+		return c.network.Remove(ctx)
+	}
+
+	return nil
+}
+
+// URL returns the URL of the Unleash server, e.g. "http://localhost:32768", where the admin UI
+// is available with the "admin" user and the "unleash4all" password.
+func (c *UnleashContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, mappedPort.Port())), nil
+}
+
+// APIURL returns the URL of the API, e.g. "http://localhost:32768/api", to be used by the backend SDKs
+// with the ClientAPIToken.
+func (c *UnleashContainer) APIURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api", nil
+}
+
+// FrontendAPIURL returns the URL of the frontend API, e.g. "http://localhost:32768/api/frontend",
+// to be used by the frontend SDKs with the FrontendAPIToken.
+func (c *UnleashContainer) FrontendAPIURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/frontend", nil
+}
+
+// ClientAPIToken returns the client API token created at startup, for the backend SDKs.
+func (c *UnleashContainer) ClientAPIToken() string {
+	return c.clientAPIToken
+}
+
+// FrontendAPIToken returns the frontend API token created at startup, for the frontend SDKs.
+func (c *UnleashContainer) FrontendAPIToken() string {
+	return c.frontendAPIToken
+}
+
+// AdminAPIToken returns the admin API token created at startup, for the admin API.
+func (c *UnleashContainer) AdminAPIToken() string {
+	return c.adminAPIToken
+}
+
+// CreateFeature creates a feature toggle with the given name in the given project, e.g. "default",
+// disabled in all the environments.
+func (c *UnleashContainer) CreateFeature(ctx context.Context, project string, name string) error {
+	body, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, "/api/admin/projects/"+url.PathEscape(project)+"/features", body)
+	return err
+}
+
+// EnableFeature enables the feature toggle with the given name, in the given project and environment,
+// e.g. "development". Unleash adds a strategy enabling the toggle for all the users if it has none.
+func (c *UnleashContainer) EnableFeature(ctx context.Context, project string, name string, environment string) error {
+	return c.setFeatureEnabled(ctx, project, name, environment, "on")
+}
+
+// DisableFeature disables the feature toggle with the given name, in the given project and environment.
+func (c *UnleashContainer) DisableFeature(ctx context.Context, project string, name string, environment string) error {
+	return c.setFeatureEnabled(ctx, project, name, environment, "off")
+}
+
+func (c *UnleashContainer) setFeatureEnabled(ctx context.Context, project string, name string, environment string, state string) error {
+	path := fmt.Sprintf("/api/admin/projects/%s/features/%s/environments/%s/%s", url.PathEscape(project), url.PathEscape(name), url.PathEscape(environment), state)
+	_, err := c.do(ctx, http.MethodPost, path, nil)
+	return err
+}
+
+func (c *UnleashContainer) do(ctx context.Context, method string, path string, body []byte) ([]byte, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.adminAPIToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("unexpected status code %d for %s %s: %s", resp.StatusCode, method, path, respBody)
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,180 @@
+package unleash_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go/modules/unleash"
+)
+
+func TestUnleash(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := unleash.RunContainer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// createFeature {
+	err = container.CreateFeature(ctx, "default", "new-checkout")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// enableFeature {
+	err = container.EnableFeature(ctx, "default", "new-checkout", "development")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("client-api", func(t *testing.T) {
+		// apiURL {
+		apiURL, err := container.APIURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var result struct {
+			Features []struct {
+				Name    string `json:"name"`
+				Enabled bool   `json:"enabled"`
+			} `json:"features"`
+		}
+		getJSON(t, apiURL+"/client/features", container.ClientAPIToken(), &result)
+
+		for _, feature := range result.Features {
+			if feature.Name == "new-checkout" {
+				if !feature.Enabled {
+					t.Fatal("expected the feature to be enabled")
+				}
+				return
+			}
+		}
+		t.Fatalf("expected the feature in %+v", result.Features)
+	})
+
+	t.Run("frontend-api", func(t *testing.T) {
+		// frontendAPIURL {
+		frontendAPIURL, err := container.FrontendAPIURL(ctx)
+		// }
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the frontend API refreshes the toggles periodically
+		deadline := time.Now().Add(30 * time.Second)
+		for {
+			var result struct {
+				Toggles []struct {
+					Name    string `json:"name"`
+					Enabled bool   `json:"enabled"`
+				} `json:"toggles"`
+			}
+			getJSON(t, frontendAPIURL, container.FrontendAPIToken(), &result)
+
+			for _, toggle := range result.Toggles {
+				if toggle.Name == "new-checkout" && toggle.Enabled {
+					return
+				}
+			}
+
+			if time.Now().After(deadline) {
+				t.Fatalf("expected the toggle to be enabled, got %+v", result.Toggles)
+			}
+
+			time.Sleep(time.Second)
+		}
+	})
+
+	t.Run("invalid-token", func(t *testing.T) {
+		apiURL, err := container.APIURL(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req, err := http.NewRequest(http.MethodGet, apiURL+"/client/features", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "default:development.invalid")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("expected status code %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+	})
+}
+
+func TestUnleashWithClientAPIToken(t *testing.T) {
+	ctx := context.Background()
+
+	// withClientAPIToken {
+	container, err := unleash.RunContainer(ctx, unleash.WithClientAPIToken("default:production.test-secret"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	if container.ClientAPIToken() != "default:production.test-secret" {
+		t.Fatalf("expected the client API token, got %s", container.ClientAPIToken())
+	}
+
+	apiURL, err := container.APIURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result struct {
+		Features []json.RawMessage `json:"features"`
+	}
+	getJSON(t, apiURL+"/client/features", container.ClientAPIToken(), &result)
+}
+
+// getJSON decodes the JSON response of a GET request authenticated with the given token.
+func getJSON(t *testing.T, url string, token string, v interface{}) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
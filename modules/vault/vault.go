@@ -3,11 +3,13 @@ package vault
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/docker/docker/api/types/container"
 
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -60,7 +62,9 @@ func WithToken(token string) testcontainers.CustomizeRequestOption {
 	}
 }
 
-// WithInitCommand is an option function that adds a set of initialization commands to the Vault's configuration
+// WithInitCommand is an option function that adds a set of initialization commands to the Vault's configuration.
+// The commands are run with the vault CLI, in order, once the container is ready, e.g. "secrets enable transit".
+// If any of the commands fails, the container fails to start, returning the output of the commands.
 func WithInitCommand(commands ...string) testcontainers.CustomizeRequestOption {
 	return func(req *testcontainers.GenericContainerRequest) {
 		commandsList := make([]string, 0, len(commands))
@@ -69,7 +73,23 @@ func WithInitCommand(commands ...string) testcontainers.CustomizeRequestOption {
 		}
 		cmd := []string{"/bin/sh", "-c", strings.Join(commandsList, " && ")}
 
-		req.WaitingFor = wait.ForAll(req.WaitingFor, wait.ForExec(cmd))
+		req.LifecycleHooks = append(req.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostStarts: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					code, reader, err := c.Exec(ctx, cmd, exec.Multiplexed())
+					if err != nil {
+						return fmt.Errorf("failed to run init commands: %w", err)
+					}
+
+					if code != 0 {
+						out, _ := io.ReadAll(reader)
+						return fmt.Errorf("init commands exited with code %d: %s", code, string(out))
+					}
+
+					return nil
+				},
+			},
+		})
 	}
 }
 
@@ -129,3 +129,14 @@ func TestVault(t *testing.T) {
 		}
 	})
 }
+
+func TestVaultWithFailingInitCommand(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := testcontainervault.RunContainer(ctx,
+		testcontainervault.WithToken(token),
+		testcontainervault.WithInitCommand("secrets enable non-existing-engine"),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "init commands exited with code")
+}
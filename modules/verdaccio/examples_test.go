@@ -0,0 +1,37 @@
+package verdaccio_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/verdaccio"
+)
+
+func ExampleRunContainer() {
+	// runVerdaccioContainer {
+	ctx := context.Background()
+
+	verdaccioContainer, err := verdaccio.RunContainer(ctx, testcontainers.WithImage("verdaccio/verdaccio:5.29.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := verdaccioContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := verdaccioContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
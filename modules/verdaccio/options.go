@@ -0,0 +1,35 @@
+package verdaccio
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Users are the passwords of the users of the htpasswd file, by name.
+	Users map[string]string
+}
+
+func defaultOptions() options {
+	return options{
+		Users: map[string]string{},
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the Verdaccio container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithUser adds a user to the htpasswd file of the registry, which can publish packages once logged in.
+// It can be called multiple times.
+func WithUser(user string, password string) Option {
+	return func(o *options) {
+		o.Users[user] = password
+	}
+}
@@ -0,0 +1,159 @@
+package verdaccio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	httpPort = "4873/tcp"
+
+	htpasswdPath = "/verdaccio/storage/htpasswd"
+)
+
+// VerdaccioContainer represents the Verdaccio container type used in the module
+type VerdaccioContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the Verdaccio container type, with the default configuration of the image:
+// the packages are published by the authenticated users, and the other packages are proxied to the npm registry.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*VerdaccioContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "verdaccio/verdaccio:5.29.0",
+		ExposedPorts: []string{httpPort},
+		WaitingFor:   wait.ForHTTP("/-/ping").WithPort(httpPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if len(settings.Users) > 0 {
+		htpasswd := renderHtpasswd(settings.Users)
+		genericContainerReq.LifecycleHooks = append(genericContainerReq.LifecycleHooks, testcontainers.ContainerLifecycleHooks{
+			PostCreates: []testcontainers.ContainerHook{
+				func(ctx context.Context, c testcontainers.Container) error {
+					// the file is writable by Verdaccio, so new users can still sign up
+					return c.CopyToContainer(ctx, htpasswd, htpasswdPath, 0o666)
+				},
+			},
+		})
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerdaccioContainer{Container: container}, nil
+}
+
+// renderHtpasswd returns the htpasswd file of the given users, with the SHA-1 passwords supported by Verdaccio.
+func renderHtpasswd(users map[string]string) []byte {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sum := sha1.Sum([]byte(users[name]))
+		sb.WriteString(name + ":{SHA}" + base64.StdEncoding.EncodeToString(sum[:]) + "\n")
+	}
+
+	return []byte(sb.String())
+}
+
+// RegistryURL returns the URL of the registry, e.g. "http://localhost:32768/", to be used as the registry
+// of the npm clients, e.g. with the --registry flag or the registry setting of the .npmrc file.
+func (c *VerdaccioContainer) RegistryURL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s/", host, port.Port()), nil
+}
+
+// Login authenticates the given user, returning the token to be used by the npm clients,
+// e.g. with the "//localhost:32768/:_authToken" setting of the .npmrc file.
+func (c *VerdaccioContainer) Login(ctx context.Context, user string, password string) (string, error) {
+	registryURL, err := c.RegistryURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string]string{"name": user, "password": password})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, registryURL+"-/user/org.couchdb.user:"+url.PathEscape(user), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("failed to login as %s, status code %d: %s", user, resp.StatusCode, respBody)
+	}
+
+	var result struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", err
+	}
+
+	return result.Token, nil
+}
+
+// NpmrcConfig returns the content of a .npmrc file using the registry, authenticated with the given token.
+func (c *VerdaccioContainer) NpmrcConfig(ctx context.Context, token string) (string, error) {
+	registryURL, err := c.RegistryURL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("registry=%s\n%s:_authToken=%s\n", registryURL, strings.TrimPrefix(registryURL, "http:"), token), nil
+}
@@ -0,0 +1,153 @@
+package verdaccio_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/verdaccio"
+)
+
+func TestVerdaccio(t *testing.T) {
+	ctx := context.Background()
+
+	// withUser {
+	container, err := verdaccio.RunContainer(ctx, verdaccio.WithUser("publisher", "s3cr3t"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// registryURL {
+	registryURL, err := container.RegistryURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// login {
+	token, err := container.Login(ctx, "publisher", "s3cr3t")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := container.Login(ctx, "publisher", "wrong"); err == nil {
+		t.Fatal("expected an error with a wrong password")
+	}
+
+	// npmrcConfig {
+	npmrc, err := container.NpmrcConfig(ctx, token)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(npmrc, "registry="+registryURL) || !strings.Contains(npmrc, ":_authToken="+token) {
+		t.Fatalf("unexpected .npmrc content %s", npmrc)
+	}
+
+	// publish the package the way npm publish does
+	req, err := http.NewRequest(http.MethodPut, registryURL+"tc-demo", bytes.NewReader(publishPayload(t, registryURL)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status code %d, got %d", http.StatusCreated, resp.StatusCode)
+	}
+
+	// install the package the way npm install does
+	resp, err = http.Get(registryURL + "tc-demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var metadata struct {
+		DistTags map[string]string `json:"dist-tags"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	if metadata.DistTags["latest"] != "1.0.0" {
+		t.Fatalf("expected the latest version to be 1.0.0, got %v", metadata.DistTags)
+	}
+}
+
+// publishPayload returns the body of the request publishing the version 1.0.0 of the tc-demo package.
+func publishPayload(t *testing.T, registryURL string) []byte {
+	t.Helper()
+
+	packageJSON := []byte(`{"name": "tc-demo", "version": "1.0.0"}`)
+
+	tarball := &bytes.Buffer{}
+	gz := gzip.NewWriter(tarball)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "package/package.json", Mode: 0o644, Size: int64(len(packageJSON))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(packageJSON); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	shasum := sha1.Sum(tarball.Bytes())
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"_id":       "tc-demo",
+		"name":      "tc-demo",
+		"dist-tags": map[string]string{"latest": "1.0.0"},
+		"versions": map[string]interface{}{
+			"1.0.0": map[string]interface{}{
+				"name":    "tc-demo",
+				"version": "1.0.0",
+				"dist": map[string]string{
+					"tarball": registryURL + "tc-demo/-/tc-demo-1.0.0.tgz",
+					"shasum":  hex.EncodeToString(shasum[:]),
+				},
+			},
+		},
+		"_attachments": map[string]interface{}{
+			"tc-demo-1.0.0.tgz": map[string]interface{}{
+				"content_type": "application/octet-stream",
+				"data":         base64.StdEncoding.EncodeToString(tarball.Bytes()),
+				"length":       tarball.Len(),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return payload
+}
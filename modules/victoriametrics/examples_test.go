@@ -0,0 +1,37 @@
+package victoriametrics_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/victoriametrics"
+)
+
+func ExampleRunContainer() {
+	// runVictoriaMetricsContainer {
+	ctx := context.Background()
+
+	victoriametricsContainer, err := victoriametrics.RunContainer(ctx, testcontainers.WithImage("victoriametrics/victoria-metrics:v1.96.0"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := victoriametricsContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := victoriametricsContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package victoriametrics
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// RetentionPeriod is the retention of the samples, e.g. "1d".
+	RetentionPeriod string
+}
+
+func defaultOptions() options {
+	return options{}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the VictoriaMetrics container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithRetentionPeriod sets the retention of the samples, with the format of the -retentionPeriod flag,
+// e.g. "1d", "2w" or "1y", or a number of months, e.g. "3". The samples outside the retention period
+// are rejected. The default retention is one month.
+func WithRetentionPeriod(period string) Option {
+	return func(o *options) {
+		o.RetentionPeriod = period
+	}
+}
@@ -0,0 +1,118 @@
+package victoriametrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const httpPort = "8428/tcp"
+
+// VictoriaMetricsContainer represents the VictoriaMetrics container type used in the module
+type VictoriaMetricsContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the VictoriaMetrics container type, running a single node.
+// The samples are visible to the queries as soon as they are flushed, instead of after the default
+// latency offset of 30 seconds, as the tests usually query the samples they've just written.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*VictoriaMetricsContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "victoriametrics/victoria-metrics:v1.96.0",
+		ExposedPorts: []string{httpPort},
+		Cmd:          []string{"-search.latencyOffset=0s", "-search.disableCache"},
+		WaitingFor:   wait.ForHTTP("/health").WithPort(httpPort).WithStartupTimeout(time.Minute),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if settings.RetentionPeriod != "" {
+		genericContainerReq.Cmd = append(genericContainerReq.Cmd, "-retentionPeriod="+settings.RetentionPeriod)
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VictoriaMetricsContainer{Container: container}, nil
+}
+
+// URL returns the base URL of VictoriaMetrics, e.g. "http://localhost:32768", which serves the
+// Prometheus querying API under /api/v1, to be used as the address of the Prometheus API clients
+// or as a Prometheus data source.
+func (c *VictoriaMetricsContainer) URL(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%s", host, port.Port()), nil
+}
+
+// RemoteWriteURL returns the URL of the Prometheus remote write endpoint, e.g. "http://localhost:32768/api/v1/write",
+// to be used by Prometheus, the OpenTelemetry Collector or any other remote write client.
+func (c *VictoriaMetricsContainer) RemoteWriteURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v1/write", nil
+}
+
+// QueryURL returns the URL of the instant query endpoint, e.g. "http://localhost:32768/api/v1/query",
+// accepting PromQL and MetricsQL queries in the "query" parameter.
+func (c *VictoriaMetricsContainer) QueryURL(ctx context.Context) (string, error) {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL + "/api/v1/query", nil
+}
+
+// ForceFlush flushes the samples received so far to the storage, making them visible to the queries.
+func (c *VictoriaMetricsContainer) ForceFlush(ctx context.Context) error {
+	baseURL, err := c.URL(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/internal/force_flush", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package victoriametrics_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/modules/victoriametrics"
+)
+
+func TestVictoriaMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	// withRetentionPeriod {
+	container, err := victoriametrics.RunContainer(ctx, victoriametrics.WithRetentionPeriod("2d"))
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	baseURL, err := container.URL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the samples are imported in the Prometheus exposition format, which is simpler than the remote write protocol
+	resp, err := http.Post(baseURL+"/api/v1/import/prometheus", "text/plain", bytes.NewBufferString("requests_total{service=\"api\"} 42\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status code %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+
+	// forceFlush {
+	err = container.ForceFlush(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// queryURL {
+	queryURL, err := container.QueryURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = http.Get(queryURL + "?query=" + url.QueryEscape(`requests_total{service="api"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			Result []struct {
+				Value []interface{} `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Data.Result) != 1 || result.Data.Result[0].Value[1] != "42" {
+		t.Fatalf("expected a single sample with value 42, got %v", result.Data.Result)
+	}
+
+	// remoteWriteURL {
+	remoteWriteURL, err := container.RemoteWriteURL(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remoteWriteURL != baseURL+"/api/v1/write" {
+		t.Fatalf("unexpected remote write URL %s", remoteWriteURL)
+	}
+}
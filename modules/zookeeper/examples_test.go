@@ -0,0 +1,37 @@
+package zookeeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/zookeeper"
+)
+
+func ExampleRunContainer() {
+	// runZooKeeperContainer {
+	ctx := context.Background()
+
+	zookeeperContainer, err := zookeeper.RunContainer(ctx, testcontainers.WithImage("zookeeper:3.8"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Clean up the container
+	defer func() {
+		if err := zookeeperContainer.Terminate(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	// }
+
+	state, err := zookeeperContainer.State(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(state.Running)
+
+	// Output:
+	// true
+}
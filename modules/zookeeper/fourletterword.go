@@ -0,0 +1,106 @@
+package zookeeper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// fourLetterWord sends the given four letter word command to the ZooKeeper server
+// listening on the given address, and returns its response.
+// See https://zookeeper.apache.org/doc/current/zookeeperAdmin.html#sc_4lw
+func fourLetterWord(ctx context.Context, address string, cmd string) (string, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := conn.Write([]byte(cmd)); err != nil {
+		return "", err
+	}
+
+	// the server closes the connection once the response is written
+	resp, err := io.ReadAll(conn)
+	if err != nil {
+		return "", err
+	}
+
+	return string(resp), nil
+}
+
+// Compiler check to ensure that ruokStrategy implements the wait.Strategy interface.
+var _ wait.Strategy = (*ruokStrategy)(nil)
+
+// ruokStrategy waits until the ZooKeeper server answers "imok" to the "ruok" four letter word command,
+// which means that it's running in a non-error state.
+type ruokStrategy struct {
+	port         nat.Port
+	timeout      time.Duration
+	pollInterval time.Duration
+}
+
+func forRuok(port nat.Port) *ruokStrategy {
+	return &ruokStrategy{
+		port:         port,
+		timeout:      60 * time.Second,
+		pollInterval: 100 * time.Millisecond,
+	}
+}
+
+// Timeout returns the startup timeout of the strategy.
+func (s *ruokStrategy) Timeout() *time.Duration {
+	return &s.timeout
+}
+
+// WaitUntilReady implements the wait.Strategy interface.
+func (s *ruokStrategy) WaitUntilReady(ctx context.Context, target wait.StrategyTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	host, err := target.Host(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: last error: %v", ctx.Err(), lastErr)
+		case <-time.After(s.pollInterval):
+		}
+
+		port, err := target.MappedPort(ctx, s.port)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		attemptCtx, cancelAttempt := context.WithTimeout(ctx, time.Second)
+		resp, err := fourLetterWord(attemptCtx, net.JoinHostPort(host, port.Port()), "ruok")
+		cancelAttempt()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp == "imok" {
+			return nil
+		}
+		lastErr = fmt.Errorf("unexpected response to ruok: %q", resp)
+	}
+}
@@ -0,0 +1,44 @@
+package zookeeper
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestFourLetterWord(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		cmd := make([]byte, 4)
+		if _, err := conn.Read(cmd); err != nil {
+			return
+		}
+		if string(cmd) == "ruok" {
+			_, _ = conn.Write([]byte("imok"))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := fourLetterWord(ctx, listener.Addr().String(), "ruok")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp != "imok" {
+		t.Fatalf("expected response to be %q, got %q", "imok", resp)
+	}
+}
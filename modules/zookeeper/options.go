@@ -0,0 +1,38 @@
+package zookeeper
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+type options struct {
+	// Config is a map of zoo.cfg properties (key) and their values, which are appended
+	// to the configuration file generated by the image, so they override the defaults.
+	Config map[string]string
+}
+
+func defaultOptions() options {
+	return options{
+		Config: make(map[string]string),
+	}
+}
+
+// Compiler check to ensure that Option implements the testcontainers.ContainerCustomizer interface.
+var _ testcontainers.ContainerCustomizer = (*Option)(nil)
+
+// Option is an option for the ZooKeeper container.
+type Option func(*options)
+
+// Customize is a NOOP. It's defined to satisfy the testcontainers.ContainerCustomizer interface.
+func (o Option) Customize(*testcontainers.GenericContainerRequest) {
+	// NOOP to satisfy interface.
+}
+
+// WithConfig sets a property of the zoo.cfg configuration file, e.g. "maxClientCnxns" or "autopurge.purgeInterval".
+// It can be called multiple times, and the last value wins for the same property.
+// Please note that the readiness check needs the "ruok" four letter word command, so it must be
+// included if the "4lw.commands.whitelist" property is set.
+func WithConfig(key string, value string) Option {
+	return func(o *options) {
+		o.Config[key] = value
+	}
+}
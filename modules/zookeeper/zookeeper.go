@@ -0,0 +1,101 @@
+package zookeeper
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+const (
+	// ClientPort is the port the ZooKeeper clients connect to.
+	ClientPort = "2181/tcp"
+)
+
+// ZooKeeperContainer represents the ZooKeeper container type used in the module
+type ZooKeeperContainer struct {
+	testcontainers.Container
+}
+
+// RunContainer creates an instance of the ZooKeeper container type, running a standalone server.
+// The container is ready once the server answers the "ruok" four letter word command.
+func RunContainer(ctx context.Context, opts ...testcontainers.ContainerCustomizer) (*ZooKeeperContainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "zookeeper:3.8",
+		ExposedPorts: []string{ClientPort},
+		Env: map[string]string{
+			// the image only allows the "srvr" command by default
+			"ZOO_4LW_COMMANDS_WHITELIST": "*",
+		},
+		WaitingFor: forRuok(ClientPort),
+	}
+
+	genericContainerReq := testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	}
+
+	settings := defaultOptions()
+	for _, opt := range opts {
+		if apply, ok := opt.(Option); ok {
+			apply(&settings)
+		}
+		opt.Customize(&genericContainerReq)
+	}
+
+	if len(settings.Config) > 0 {
+		entries := make([]string, 0, len(settings.Config))
+		for key, value := range settings.Config {
+			if strings.ContainsAny(key+value, " \t\n") {
+				return nil, fmt.Errorf("the config property %q cannot contain whitespaces", key)
+			}
+			entries = append(entries, key+"="+value)
+		}
+		sort.Strings(entries)
+
+		// the entries are appended to the zoo.cfg file generated by the image
+		genericContainerReq.Env["ZOO_CFG_EXTRA"] = strings.Join(entries, " ")
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, genericContainerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ZooKeeperContainer{Container: container}, nil
+}
+
+// ConnectionString returns the connection string for the ZooKeeper server, in the "host:port" format,
+// which can be used by ZooKeeper clients, or as the "zookeeper.connect" property of a Kafka broker
+// running in the host.
+func (c *ZooKeeperContainer) ConnectionString(ctx context.Context) (string, error) {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := c.MappedPort(ctx, ClientPort)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, port.Port()), nil
+}
+
+// FourLetterWord sends the given four letter word command (e.g. "mntr", "conf" or "srvr") to the
+// ZooKeeper server, and returns its response.
+func (c *ZooKeeperContainer) FourLetterWord(ctx context.Context, cmd string) (string, error) {
+	if len(cmd) != 4 {
+		return "", fmt.Errorf("invalid four letter word command: %q", cmd)
+	}
+
+	address, err := c.ConnectionString(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fourLetterWord(ctx, address, cmd)
+}
@@ -0,0 +1,99 @@
+package zookeeper
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-zookeeper/zk"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestZooKeeper(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := RunContainer(ctx, testcontainers.WithImage("zookeeper:3.8"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// connectionString {
+	connectionString, err := container.ConnectionString(ctx)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, _, err := zk.Connect([]string{connectionString}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = conn.Create("/testcontainers", []byte("hello"), 0, zk.WorldACL(zk.PermAll))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _, err := conn.Get("/testcontainers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "hello" {
+		t.Fatalf("expected data to be %q, got %q", "hello", string(data))
+	}
+}
+
+func TestZooKeeperWithConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// withConfig {
+	container, err := RunContainer(ctx,
+		testcontainers.WithImage("zookeeper:3.8"),
+		WithConfig("maxClientCnxns", "42"),
+		WithConfig("tickTime", "1000"),
+	)
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clean up the container after the test is complete
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate container: %s", err)
+		}
+	})
+
+	// fourLetterWord {
+	conf, err := container.FourLetterWord(ctx, "conf")
+	// }
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{"maxClientCnxns=42", "tickTime=1000"} {
+		if !strings.Contains(conf, expected) {
+			t.Fatalf("expected the configuration to contain %q, got %s", expected, conf)
+		}
+	}
+}
+
+func TestZooKeeperWithInvalidConfig(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := RunContainer(ctx, WithConfig("maxClientCnxns", "4 2"))
+	if err == nil {
+		t.Fatal("expected an error for a config value with whitespaces")
+	}
+}
@@ -557,3 +557,42 @@ func TestWithNewNetworkContextTimeout(t *testing.T) {
 	assert.Empty(t, req.Networks)
 	assert.Empty(t, req.NetworkAliases)
 }
+
+func TestDisconnectAndConnect(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, nw.Remove(ctx))
+	}()
+
+	nginxC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image: nginxAlpineImage,
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, nginxC.Terminate(ctx))
+	}()
+
+	// connectContainer {
+	err = nw.Connect(ctx, nginxC, "nginx")
+	// }
+	require.NoError(t, err)
+
+	aliases, err := nginxC.NetworkAliases(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, aliases[nw.Name], "nginx")
+
+	// disconnectContainer {
+	err = nw.Disconnect(ctx, nginxC)
+	// }
+	require.NoError(t, err)
+
+	networks, err := nginxC.Networks(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, networks, nw.Name)
+}
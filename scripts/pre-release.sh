@@ -59,11 +59,12 @@ function bumpVersion() {
     ls -d */ | grep -v "_template" | while read -r module; do
       module="${module%?}" # remove trailing slash
       module_mod_file="${module}/go.mod" # e.g. modules/mongodb/go.mod
+      # bump the core module and the sibling modules required by the module, e.g. modules/kafkaconnect for modules/debezium
       if [[ "${DRY_RUN}" == "true" ]]; then
-        echo "sed \"s/testcontainers-go v.*/testcontainers-go v${versionToBumpWithoutV}/g\" ${module_mod_file} > ${module_mod_file}.tmp"
+        echo "sed \"s#\(testcontainers-go\(/[a-z]*/[a-z0-9-]*\)\{0,1\}\) v[^ ]*#\1 v${versionToBumpWithoutV}#g\" ${module_mod_file} > ${module_mod_file}.tmp"
         echo "mv ${module_mod_file}.tmp ${module_mod_file}"
       else
-        sed "s/testcontainers-go v.*/testcontainers-go v${versionToBumpWithoutV}/g" ${module_mod_file} > ${module_mod_file}.tmp
+        sed "s#\(testcontainers-go\(/[a-z]*/[a-z0-9-]*\)\{0,1\}\) v[^ ]*#\1 v${versionToBumpWithoutV}#g" ${module_mod_file} > ${module_mod_file}.tmp
         mv ${module_mod_file}.tmp ${module_mod_file}
       fi
     done
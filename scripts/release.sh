@@ -67,19 +67,57 @@ function main() {
   # Trigger the Go proxy to fetch the core module
   curlGolangProxy "${REPOSITORY}" "${vVersion}" # e.g. github.com/testcontainers/testcontainers-go/@v/v0.0.1.info
 
-  # Trigger the Go proxy to fetch the modules
+  # Trigger the Go proxy to fetch the modules, the required sibling modules first
   for directory in "${DIRECTORIES[@]}"
   do
     cd "${ROOT_DIR}/${directory}"
 
-    ls -d */ | grep -v "_template" | while read -r module; do
-      module="${module%?}" # remove trailing slash
+    modulesInDependencyOrder "${directory}" | while read -r module; do
       module_path="${REPOSITORY}/${directory}/${module}"
       curlGolangProxy "${module_path}" "${vVersion}" # e.g. github.com/testcontainers/testcontainers-go/modules/mongodb/@v/v0.0.1.info
     done
   done
 }
 
+# This function prints the modules of the directory, so that the sibling modules required by a module,
+# e.g. modules/kafkaconnect for modules/debezium, are printed before it.
+function modulesInDependencyOrder() {
+  local directory="${1}"
+  local pending=()
+  local released=" "
+
+  for module in $(ls -d */ | grep -v "_template"); do
+    pending+=("${module%?}") # remove trailing slash
+  done
+
+  while [[ ${#pending[@]} -gt 0 ]]; do
+    local remaining=()
+    for module in "${pending[@]}"; do
+      local ready="true"
+      # the sibling modules required by the module, excluding its own module statement
+      for dependency in $(grep -o "${REPOSITORY}/${directory}/[a-z0-9-]* v" "${module}/go.mod" | cut -d '/' -f 5 | cut -d ' ' -f 1); do
+        if [[ "${dependency}" != "${module}" && "${released}" != *" ${dependency} "* ]]; then
+          ready="false"
+        fi
+      done
+
+      if [[ "${ready}" == "true" ]]; then
+        echo "${module}"
+        released="${released}${module} "
+      else
+        remaining+=("${module}")
+      fi
+    done
+
+    # print the modules with circular or missing dependencies as they are
+    if [[ ${#remaining[@]} -eq ${#pending[@]} ]]; then
+      printf '%s\n' "${remaining[@]}"
+      return
+    fi
+    pending=("${remaining[@]}")
+  done
+}
+
 # This function is used to trigger the Go proxy to fetch the module.
 # See https://pkg.go.dev/about#adding-a-package for more details.
 function curlGolangProxy() {